@@ -0,0 +1,505 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"container/list"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time of a cache.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Tick returns a channel receiving a value every "d" and a function
+	// stopping it.
+	Tick(d time.Duration) (<-chan time.Time, func())
+}
+
+// SystemClock is the Clock of the time package.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Tick(d time.Duration) (<-chan time.Time, func()) {
+	t := time.NewTicker(d)
+	return t.C, t.Stop
+}
+
+// EvictionPolicy chooses the entry removed when a cache is full.
+type EvictionPolicy int
+
+const (
+	// EvictLRU removes the least recently used entry.
+	EvictLRU EvictionPolicy = iota
+
+	// EvictLFU removes the least frequently used entry, the least recently
+	// used one on a tie.
+	EvictLFU
+)
+
+// EvictionReason says why an entry has been removed from a cache.
+type EvictionReason int
+
+const (
+	// EvictExpired is used when the TTL of the entry is over.
+	EvictExpired EvictionReason = iota
+
+	// EvictCapacity is used when the cache is full.
+	EvictCapacity
+)
+
+// CacheStats are the counters of a cache.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// CacheOption configures SyncCache.
+type CacheOption func(*tsafeCache)
+
+// WithTTL sets the TTL of the entries written without SetWithTTL, they
+// never expire by default.
+func WithTTL(ttl time.Duration) CacheOption {
+	return func(c *tsafeCache) {
+		c.ttl = ttl
+	}
+}
+
+// WithMaxEntries limits the number of entries, the entries chosen by
+// "policy" are removed when the cache is full.
+func WithMaxEntries(n int, policy EvictionPolicy) CacheOption {
+	return func(c *tsafeCache) {
+		c.max, c.policy = n, policy
+	}
+}
+
+// WithOnEvict sets a function called after an entry has expired or has been
+// removed to make room. It is called without lock and may use the cache.
+func WithOnEvict(f func(k string, v any, reason EvictionReason)) CacheOption {
+	return func(c *tsafeCache) {
+		c.onEvict = f
+	}
+}
+
+// WithCleanupInterval sets how often the expired entries are removed in the
+// background, every minute by default. The janitor is disabled when d <= 0.
+func WithCleanupInterval(d time.Duration) CacheOption {
+	return func(c *tsafeCache) {
+		c.interval = d
+	}
+}
+
+// WithClock sets the source of time, SystemClock by default.
+func WithClock(clock Clock) CacheOption {
+	return func(c *tsafeCache) {
+		c.clock = clock
+	}
+}
+
+// TSafeCache is a TSafeMap whose entries expire and are evicted when it is full.
+// Expired entries are never returned, even before the janitor removes them.
+type TSafeCache interface {
+	TSafeMap
+
+	// SetWithTTL sets an entry expiring after "ttl", it never expires when ttl <= 0.
+	SetWithTTL(k string, v any, ttl time.Duration)
+
+	// DeleteExpired removes the expired entries and returns how many were removed.
+	DeleteExpired() int
+
+	// Stats returns the hit, miss and eviction counters.
+	Stats() CacheStats
+
+	// Close stops the janitor goroutine.
+	Close()
+}
+
+// SyncCache return a new thread-safe cache. Every write but SetWithTTL
+// uses the TTL given by WithTTL, CompareAndSwap and Update keep the expiry
+// of the entry they change.
+func SyncCache(opts ...CacheOption) TSafeCache {
+	c := &tsafeCache{
+		mu:       &sync.Mutex{},
+		hub:      newWatchHub(),
+		entries:  map[string]*list.Element{},
+		clock:    SystemClock,
+		interval: time.Minute,
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.interval > 0 {
+		go c.janitor()
+	} else {
+		close(c.stopped)
+	}
+	return c
+}
+
+type cacheEntry struct {
+	key     string
+	value   any
+	expires time.Time
+	hits    uint64
+}
+
+type evictedEntry struct {
+	key    string
+	value  any
+	reason EvictionReason
+}
+
+type tsafeCache struct {
+	mu      *sync.Mutex
+	entries map[string]*list.Element
+	order   list.List // most recently used at the front
+	stats   CacheStats
+	evicted []evictedEntry
+	hub     *watchHub
+
+	ttl      time.Duration
+	max      int
+	policy   EvictionPolicy
+	onEvict  func(k string, v any, reason EvictionReason)
+	interval time.Duration
+	clock    Clock
+
+	closeOnce sync.Once
+	done      chan struct{}
+	stopped   chan struct{}
+}
+
+func (c *tsafeCache) janitor() {
+	defer close(c.stopped)
+
+	tick, stop := c.clock.Tick(c.interval)
+	defer stop()
+
+	for {
+		select {
+		case <-tick:
+			c.DeleteExpired()
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// unlock releases the lock, then calls the eviction callback for the
+// entries evicted while it was held.
+func (c *tsafeCache) unlock() {
+	evicted := c.evicted
+	c.evicted = nil
+	c.mu.Unlock()
+
+	if c.onEvict != nil {
+		for _, e := range evicted {
+			c.onEvict(e.key, e.value, e.reason)
+		}
+	}
+}
+
+func (c *tsafeCache) expired(e *cacheEntry, now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// lookup returns the live entry of the key, an expired entry is evicted.
+func (c *tsafeCache) lookup(k string) (*cacheEntry, bool) {
+	el, ok := c.entries[k]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*cacheEntry)
+	if c.expired(e, c.clock.Now()) {
+		c.evict(el, EvictExpired)
+		return nil, false
+	}
+	return e, true
+}
+
+// touch records a read of the entry.
+func (c *tsafeCache) touch(k string) (v any, ok bool) {
+	e, ok := c.lookup(k)
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.stats.Hits++
+	e.hits++
+	c.order.MoveToFront(c.entries[k])
+	return e.value, true
+}
+
+func (c *tsafeCache) set(k string, v any, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.clock.Now().Add(ttl)
+	}
+
+	if el, ok := c.entries[k]; ok {
+		e := el.Value.(*cacheEntry)
+		old := e.value
+		e.value, e.expires = v, expires
+		c.order.MoveToFront(el)
+		c.hub.changed(k, old, true, v)
+		return
+	}
+
+	c.entries[k] = c.order.PushFront(&cacheEntry{key: k, value: v, expires: expires})
+	c.hub.changed(k, nil, false, v)
+	for c.max > 0 && len(c.entries) > c.max {
+		c.evict(c.victim(), EvictCapacity)
+	}
+}
+
+// replace changes the value of a live entry and keeps its expiry.
+func (c *tsafeCache) replace(e *cacheEntry, v any) {
+	old := e.value
+	e.value = v
+	c.order.MoveToFront(c.entries[e.key])
+	c.hub.changed(e.key, old, true, v)
+}
+
+// victim returns the entry to remove when the cache is full, the entry
+// just written at the front is never chosen.
+func (c *tsafeCache) victim() *list.Element {
+	victim := c.order.Back()
+	if c.policy != EvictLFU {
+		return victim
+	}
+
+	for el := victim.Prev(); el != c.order.Front(); el = el.Prev() {
+		if el.Value.(*cacheEntry).hits < victim.Value.(*cacheEntry).hits {
+			victim = el
+		}
+	}
+	return victim
+}
+
+func (c *tsafeCache) evict(el *list.Element, reason EvictionReason) {
+	e := c.remove(el)
+	c.stats.Evictions++
+	c.evicted = append(c.evicted, evictedEntry{e.key, e.value, reason})
+}
+
+// remove the entry and notify the watchers.
+func (c *tsafeCache) remove(el *list.Element) *cacheEntry {
+	e := c.order.Remove(el).(*cacheEntry)
+	delete(c.entries, e.key)
+	c.hub.deleted(e.key, e.value)
+	return e
+}
+
+// live returns the entries that haven't expired.
+func (c *tsafeCache) live() Map {
+	now := c.clock.Now()
+	out := make(Map, len(c.entries))
+	for k, el := range c.entries {
+		if e := el.Value.(*cacheEntry); !c.expired(e, now) {
+			out[k] = e.value
+		}
+	}
+	return out
+}
+
+func (c *tsafeCache) Add(k string, v any) {
+	c.mu.Lock()
+	if _, ok := c.lookup(k); !ok {
+		c.set(k, v, c.ttl)
+	}
+	c.unlock()
+}
+
+func (c *tsafeCache) CompareAndSwap(k string, old, new any) (ok bool) {
+	c.mu.Lock()
+	if e, exists := c.lookup(k); exists && reflect.DeepEqual(e.value, old) {
+		c.replace(e, new)
+		ok = true
+	}
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Delete(k string) (ok bool) {
+	_, ok = c.LoadAndDelete(k)
+	return
+}
+
+func (c *tsafeCache) DeleteExpired() (n int) {
+	c.mu.Lock()
+	now := c.clock.Now()
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		if c.expired(el.Value.(*cacheEntry), now) {
+			c.evict(el, EvictExpired)
+			n++
+		}
+		el = next
+	}
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Find(matcher Matcher) (k string, v any, ok bool) {
+	c.mu.Lock()
+	k, v, ok = c.live().Find(matcher)
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) FindAll(matcher Matcher) (out Map) {
+	c.mu.Lock()
+	out = c.live().FindAll(matcher)
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Get(k string) (v any, ok bool) {
+	c.mu.Lock()
+	v, ok = c.touch(k)
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) GetOrSet(k string, v any) (actual any, loaded bool) {
+	c.mu.Lock()
+	if actual, loaded = c.touch(k); !loaded {
+		c.set(k, v, c.ttl)
+		actual = v
+	}
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Keys() (out []string) {
+	c.mu.Lock()
+	out = c.live().Keys()
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Len() (n int) {
+	c.mu.Lock()
+	n = len(c.live())
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) LoadAndDelete(k string) (v any, ok bool) {
+	c.mu.Lock()
+	if _, ok = c.lookup(k); ok {
+		v = c.remove(c.entries[k]).value
+	}
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Map() (out Map) {
+	c.mu.Lock()
+	out = c.live()
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Range(f func(k string, v any) bool) {
+	for k, v := range c.Map() {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+func (c *tsafeCache) Set(k string, v any) {
+	c.SetWithTTL(k, v, c.ttl)
+}
+
+func (c *tsafeCache) SetWithTTL(k string, v any, ttl time.Duration) {
+	c.mu.Lock()
+	c.set(k, v, ttl)
+	c.unlock()
+}
+
+func (c *tsafeCache) Reset() {
+	c.mu.Lock()
+	old := c.live()
+	c.entries = map[string]*list.Element{}
+	c.order.Init()
+	c.hub.reset(old)
+	c.unlock()
+}
+
+func (c *tsafeCache) Stats() (s CacheStats) {
+	c.mu.Lock()
+	s = c.stats
+	c.unlock()
+	return
+}
+
+func (c *tsafeCache) Update(k string, f func(old any, ok bool) any) (v any) {
+	c.mu.Lock()
+	defer c.unlock()
+
+	e, ok := c.lookup(k)
+	if !ok {
+		v = f(nil, false)
+		c.set(k, v, c.ttl)
+		return
+	}
+
+	v = f(e.value, true)
+	c.replace(e, v)
+	return
+}
+
+func (c *tsafeCache) Watch(matcher Matcher, opts ...WatchOption) *Subscription {
+	return c.hub.watch(matcher, opts)
+}
+
+func (c *tsafeCache) Close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+	<-c.stopped
+}
+
+// Snapshot writes the entries that haven't expired, their TTL is not saved.
+func (c *tsafeCache) Snapshot(w io.Writer, format SnapshotFormat) error {
+	return writeSnapshot(w, "map", format, c.Map())
+}
+
+// Restore replaces the entries, they are written with the default TTL.
+func (c *tsafeCache) Restore(r io.Reader) error {
+	values, err := readMapSnapshot(r)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	old := c.live()
+	c.entries = map[string]*list.Element{}
+	c.order.Init()
+	c.hub.reset(old)
+	for _, k := range sortedKeys(values) {
+		c.set(k, values[k], c.ttl)
+	}
+	c.unlock()
+	return nil
+}
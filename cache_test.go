@@ -0,0 +1,200 @@
+package types
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a Clock moved by hand, its ticks are sent by the test.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	tick    chan time.Time
+	stopped bool
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), tick: make(chan time.Time)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Tick(time.Duration) (<-chan time.Time, func()) {
+	return c.tick, func() {
+		c.mu.Lock()
+		c.stopped = true
+		c.mu.Unlock()
+	}
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+type evictLog struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (l *evictLog) add(k string, _ any, reason EvictionReason) {
+	l.mu.Lock()
+	l.keys = append(l.keys, k+map[EvictionReason]string{EvictExpired: ":expired", EvictCapacity: ":capacity"}[reason])
+	l.mu.Unlock()
+}
+
+func (l *evictLog) list() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string{}, l.keys...)
+}
+
+func TestSyncCache_TTL(t *testing.T) {
+	clock, log := newFakeClock(), &evictLog{}
+	c := SyncCache(WithClock(clock), WithTTL(time.Minute), WithCleanupInterval(0), WithOnEvict(log.add))
+	defer c.Close()
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, time.Hour)
+	c.SetWithTTL("c", 3, 0)
+
+	clock.Advance(59 * time.Second)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	clock.Advance(time.Second)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"a:expired"}, log.list())
+	assert.Equal(t, 2, c.Len())
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, Map{"c": 3}, c.Map())
+	assert.Equal(t, 1, c.DeleteExpired())
+	assert.Equal(t, []string{"a:expired", "b:expired"}, log.list())
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Evictions: 2}, c.Stats())
+
+	// Expired entries are absent for every method.
+	c.SetWithTTL("d", 4, time.Second)
+	clock.Advance(time.Second)
+	c.Add("d", 5)
+	v, _ = c.Get("d")
+	assert.Equal(t, 5, v)
+}
+
+func TestSyncCache_UpdateKeepsTTL(t *testing.T) {
+	clock := newFakeClock()
+	c := SyncCache(WithClock(clock), WithCleanupInterval(0))
+	defer c.Close()
+
+	c.SetWithTTL("session", 1, time.Minute)
+	c.SetWithTTL("token", "a", time.Minute)
+	assert.Equal(t, 2, c.Update("session", func(old any, ok bool) any { return old.(int) + 1 }))
+	assert.True(t, c.CompareAndSwap("token", "a", "b"))
+
+	clock.Advance(time.Hour)
+	_, ok := c.Get("session")
+	assert.False(t, ok)
+	_, ok = c.Get("token")
+	assert.False(t, ok)
+
+	// A missing key is written with the default TTL, which never expires here.
+	c.Update("session", func(old any, ok bool) any { return 1 })
+	clock.Advance(time.Hour)
+	v, ok := c.Get("session")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+}
+
+func TestSyncCache_LRU(t *testing.T) {
+	log := &evictLog{}
+	c := SyncCache(WithClock(newFakeClock()), WithMaxEntries(2, EvictLRU), WithCleanupInterval(0), WithOnEvict(log.add))
+	defer c.Close()
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+
+	keys := c.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, []string{"a", "c"}, keys)
+	assert.Equal(t, []string{"b:capacity"}, log.list())
+}
+
+func TestSyncCache_LFU(t *testing.T) {
+	c := SyncCache(WithClock(newFakeClock()), WithMaxEntries(2, EvictLFU), WithCleanupInterval(0))
+	defer c.Close()
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Set("c", 3)
+	c.Set("d", 4)
+
+	keys := c.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, []string{"a", "d"}, keys)
+	assert.Equal(t, uint64(2), c.Stats().Evictions)
+}
+
+func TestSyncCache_Janitor(t *testing.T) {
+	clock, log := newFakeClock(), &evictLog{}
+	c := SyncCache(WithClock(clock), WithTTL(time.Second), WithOnEvict(log.add))
+
+	c.Set("a", 1)
+	clock.Advance(time.Second)
+
+	// The second tick is received once the first one has been handled.
+	clock.tick <- clock.Now()
+	clock.tick <- clock.Now()
+	assert.Equal(t, []string{"a:expired"}, log.list())
+
+	c.Close()
+	c.Close()
+	clock.mu.Lock()
+	assert.True(t, clock.stopped)
+	clock.mu.Unlock()
+}
+
+func TestSyncCache_Map(t *testing.T) {
+	c := SyncCache(WithCleanupInterval(0))
+	defer c.Close()
+
+	v, loaded := c.GetOrSet("a", 1)
+	assert.False(t, loaded)
+	assert.Equal(t, 1, v)
+
+	v, loaded = c.GetOrSet("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, v)
+
+	assert.True(t, c.CompareAndSwap("a", 1, 2))
+	assert.Equal(t, 3, c.Update("a", func(old any, _ bool) any { return old.(int) + 1 }))
+
+	_, v, ok := c.Find(func(_ string, v any) bool { return v == 3 })
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, Map{"a": 3}, c.FindAll(func(string, any) bool { return true }))
+
+	v, ok = c.LoadAndDelete("a")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.False(t, c.Delete("a"))
+
+	c.Set("b", 1)
+	c.Reset()
+	assert.Equal(t, 0, c.Len())
+}
@@ -0,0 +1,173 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Canonical returns the deterministic JSON encoding of the map: keys are
+// sorted, there is no whitespace and numbers are normalised, so that two
+// maps that are Equal have the same encoding. Integral numbers are written
+// without fraction, like 1 for 1.0, and the others in their shortest form.
+func (m Map) Canonical() ([]byte, error) {
+	b := bytes.NewBuffer([]byte{})
+	if err := writeCanonical(b, m); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Hash returns the SHA-256 of the canonical encoding of the map.
+func (m Map) Hash() ([sha256.Size]byte, error) {
+	b, err := m.Canonical()
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
+
+// Hash64 returns the 64-bit FNV-1a of the canonical encoding of the map,
+// it is faster than Hash but isn't collision resistant.
+func (m Map) Hash64() (uint64, error) {
+	b, err := m.Canonical()
+	if err != nil {
+		return 0, err
+	}
+
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64(), nil
+}
+
+func writeCanonical(b *bytes.Buffer, v any) error {
+	switch t := v.(type) {
+	case nil:
+		b.WriteString("null")
+		return nil
+	case Map:
+		return writeCanonicalMap(b, t)
+	case map[string]any:
+		return writeCanonicalMap(b, t)
+	case *OrderedMap:
+		return writeCanonicalMap(b, t.Map())
+	case json.Number:
+		return writeCanonicalNumber(b, string(t))
+	case json.Marshaler:
+		return writeCanonicalJSON(b, t)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Bool:
+		b.WriteString(strconv.FormatBool(rv.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(rv.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		b.WriteString(strconv.FormatUint(rv.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return writeCanonicalFloat(b, rv.Float())
+	case reflect.String:
+		writeCanonicalString(b, rv.String())
+	case reflect.Slice, reflect.Array:
+		if _, ok := v.([]byte); ok {
+			return writeCanonicalJSON(b, v)
+		}
+
+		b.WriteByte('[')
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := writeCanonical(b, rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(']')
+	default:
+		return writeCanonicalJSON(b, v)
+	}
+	return nil
+}
+
+func writeCanonicalMap(b *bytes.Buffer, m map[string]any) error {
+	b.WriteByte('{')
+	for i, k := range sortedKeys(m) {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writeCanonicalString(b, k)
+		b.WriteByte(':')
+		if err := writeCanonical(b, m[k]); err != nil {
+			return err
+		}
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+func writeCanonicalString(b *bytes.Buffer, s string) {
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	b.Truncate(b.Len() - 1)
+}
+
+func writeCanonicalNumber(b *bytes.Buffer, s string) error {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		b.WriteString(strconv.FormatInt(n, 10))
+		return nil
+	}
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		b.WriteString(strconv.FormatUint(n, 10))
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("types: invalid number %q: %w", s, err)
+	}
+	return writeCanonicalFloat(b, f)
+}
+
+func writeCanonicalFloat(b *bytes.Buffer, f float64) error {
+	switch {
+	case math.IsNaN(f) || math.IsInf(f, 0):
+		return fmt.Errorf("types: unsupported number %v", f)
+	case f == 0:
+		b.WriteByte('0')
+	case f == math.Trunc(f) && math.Abs(f) < 1e21:
+		b.WriteString(strconv.FormatFloat(f, 'f', -1, 64))
+	default:
+		b.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	}
+	return nil
+}
+
+// writeCanonicalJSON encodes "v" with encoding/json, then writes the result
+// in canonical form.
+func writeCanonicalJSON(b *bytes.Buffer, v any) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var out any
+	if err := dec.Decode(&out); err != nil {
+		return err
+	}
+	return writeCanonical(b, out)
+}
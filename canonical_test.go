@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap_Canonical(t *testing.T) {
+	m := Map{
+		"z":    1.0,
+		"a":    Map{"y": uint8(2), "b": []any{3.5, "<&>", nil, true}},
+		"big":  1e21,
+		"tiny": 0.0000001,
+		"neg":  math.Copysign(0, -1),
+		"n":    json.Number("4.0"),
+		"ids":  Ints{1, 2},
+		"date": NewDate(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+		"om":   OrderedMapFrom(map[string]any{"b": 1, "a": 2}),
+	}
+
+	b, err := m.Canonical()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a":{"b":[3.5,"<&>",null,true],"y":2},"big":1e+21,"date":"2026-01-02","ids":[1,2],"n":4,"neg":0,"om":{"a":2,"b":1},"tiny":1e-07,"z":1}`, string(b))
+
+	_, err = Map{"nan": math.NaN()}.Canonical()
+	assert.Error(t, err)
+}
+
+func TestMap_Hash(t *testing.T) {
+	a := Map{"id": 1, "tags": Strings{"a"}, "meta": map[string]any{"score": 2.0, "n": uint64(1e19)}}
+	b := Map{"meta": Map{"n": 1e19, "score": 2}, "tags": []any{"a"}, "id": json.Number("1")}
+	assert.True(t, a.Equal(b))
+
+	ha, err := a.Hash()
+	assert.NoError(t, err)
+	hb, err := b.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, ha, hb)
+
+	fa, err := a.Hash64()
+	assert.NoError(t, err)
+	fb, err := b.Hash64()
+	assert.NoError(t, err)
+	assert.Equal(t, fa, fb)
+
+	b.Set("id", 2)
+	assert.False(t, a.Equal(b))
+	hb, _ = b.Hash()
+	assert.NotEqual(t, ha, hb)
+	fb, _ = b.Hash64()
+	assert.NotEqual(t, fa, fb)
+}
+
+func TestMap_SortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, Map{"c": 1, "a": 2, "b": 3}.SortedKeys())
+	assert.Equal(t, []string{}, Map{}.SortedKeys())
+}
+
+func TestMap_Equal(t *testing.T) {
+	assert.False(t, Map{"a": []any{1, Map{"b": int32(2)}}}.Equal(map[string]any{"a": Ints{1}}))
+	assert.True(t, Map{"a": []any{1, Map{"b": int32(2)}}}.Equal(map[string]any{"a": []any{1.0, map[string]any{"b": uint(2)}}}))
+	assert.False(t, Map{"a": 1}.Equal(map[string]any{"a": "1"}))
+	assert.False(t, Map{"a": 1}.Equal(map[string]any{"a": 1, "b": 2}))
+	assert.True(t, Map{}.Equal(nil))
+}
@@ -0,0 +1,291 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"io"
+	"reflect"
+	"sync"
+)
+
+// ConcurrentMap abstract the implementation of SyncMapOf, it has the methods
+// of TSafeMap with typed keys and values.
+type ConcurrentMap[K comparable, V any] interface {
+	// Add a new entry if the given key is not filled.
+	Add(K, V)
+
+	// CompareAndSwap replaces the value of the key if it is deeply equal to
+	// "old" and say if it has been replaced.
+	CompareAndSwap(k K, old, new V) bool
+
+	// Delete the key and say if it existed.
+	Delete(K) bool
+
+	// Find the first element matching the pattern.
+	Find(func(k K, v V) bool) (K, V, bool)
+
+	// FindAll elements matching the pattern.
+	FindAll(func(k K, v V) bool) Dict[K, V]
+
+	// Get an element from the key.
+	Get(K) (V, bool)
+
+	// GetOrSet returns the value of the key if it exists, otherwise it sets
+	// and returns "v". The boolean says if the value was loaded.
+	GetOrSet(k K, v V) (V, bool)
+
+	// Keys return the list of keys.
+	Keys() []K
+
+	// Len returns the size of the map.
+	Len() int
+
+	// LoadAndDelete deletes the key and returns its previous value.
+	LoadAndDelete(K) (V, bool)
+
+	// Dict convert ConcurrentMap to Dict.
+	Dict() Dict[K, V]
+
+	// Map convert ConcurrentMap to Map, keys are converted with ToString.
+	Map() Map
+
+	// Range calls "f" for each entry of a snapshot until it returns false,
+	// "f" may change the map.
+	Range(f func(k K, v V) bool)
+
+	// Set a new entry or change an entry for the given key "k".
+	Set(K, V)
+
+	// Reset the values.
+	Reset()
+
+	// Update sets the key to the value returned by "f", which is called with
+	// the current value while the map is locked. The new value is returned.
+	Update(k K, f func(old V, ok bool) V) V
+
+	// Watch subscribes to the changes of the keys matched by "matcher", all
+	// the keys are watched when it is nil. The keys of the events and of the
+	// matcher are converted with ToString.
+	Watch(matcher Matcher, opts ...WatchOption) *Subscription
+
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
+
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
+}
+
+// SyncMapOf return a new thread-safe Dict of K and V.
+func SyncMapOf[K comparable, V any]() ConcurrentMap[K, V] {
+	return SyncMapFrom(Dict[K, V]{})
+}
+
+// SyncMapFrom return a new thread-safe Dict holding a copy of "d".
+func SyncMapFrom[K comparable, V any](d Dict[K, V]) ConcurrentMap[K, V] {
+	return &concurrentMap[K, V]{
+		&sync.RWMutex{},
+		d.Copy(),
+		newWatchHub(),
+	}
+}
+
+type concurrentMap[K comparable, V any] struct {
+	mu     *sync.RWMutex
+	values Dict[K, V]
+	hub    *watchHub
+}
+
+// watched says if the map has subscriptions, so that keys are only
+// converted for them.
+func (m *concurrentMap[K, V]) watched() bool {
+	return m.hub.n.Load() > 0
+}
+
+// set the value of the key and notify the watchers, the lock must be held.
+func (m *concurrentMap[K, V]) set(k K, v V) {
+	old, ok := m.values[k]
+	m.values[k] = v
+	if m.watched() {
+		m.hub.changed(keyString(k), old, ok, v)
+	}
+}
+
+// replace the values and notify the watchers, the lock must be held.
+func (m *concurrentMap[K, V]) replace(values Dict[K, V]) {
+	old := m.values
+	m.values = values
+	if !m.watched() {
+		return
+	}
+
+	m.hub.reset(old.Map())
+	added := values.Map()
+	for _, k := range sortedKeys(added) {
+		m.hub.changed(k, nil, false, added[k])
+	}
+}
+
+// delete the key and notify the watchers, the lock must be held.
+func (m *concurrentMap[K, V]) delete(k K) (old V, ok bool) {
+	if old, ok = m.values[k]; ok {
+		delete(m.values, k)
+		if m.watched() {
+			m.hub.deleted(keyString(k), old)
+		}
+	}
+	return
+}
+
+func (m *concurrentMap[K, V]) Add(k K, v V) {
+	m.mu.Lock()
+	if _, ok := m.values[k]; !ok {
+		m.set(k, v)
+	}
+	m.mu.Unlock()
+}
+
+func (m *concurrentMap[K, V]) CompareAndSwap(k K, old, new V) (ok bool) {
+	m.mu.Lock()
+	if v, exists := m.values[k]; exists && reflect.DeepEqual(v, old) {
+		m.set(k, new)
+		ok = true
+	}
+	m.mu.Unlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Delete(k K) (ok bool) {
+	m.mu.Lock()
+	_, ok = m.delete(k)
+	m.mu.Unlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Find(matcher func(k K, v V) bool) (k K, v V, ok bool) {
+	m.mu.RLock()
+	k, v, ok = m.values.Find(matcher)
+	m.mu.RUnlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) FindAll(matcher func(k K, v V) bool) (out Dict[K, V]) {
+	m.mu.RLock()
+	out = m.values.FindAll(matcher)
+	m.mu.RUnlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Get(k K) (v V, ok bool) {
+	m.mu.RLock()
+	v, ok = m.values[k]
+	m.mu.RUnlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) GetOrSet(k K, v V) (actual V, loaded bool) {
+	m.mu.Lock()
+	if actual, loaded = m.values[k]; !loaded {
+		m.set(k, v)
+		actual = v
+	}
+	m.mu.Unlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Keys() (out []K) {
+	m.mu.RLock()
+	out = m.values.Keys()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Len() (n int) {
+	m.mu.RLock()
+	n = len(m.values)
+	m.mu.RUnlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) LoadAndDelete(k K) (v V, ok bool) {
+	m.mu.Lock()
+	v, ok = m.delete(k)
+	m.mu.Unlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Dict() (out Dict[K, V]) {
+	m.mu.RLock()
+	out = m.values.Copy()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Map() (out Map) {
+	m.mu.RLock()
+	out = m.values.Map()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *concurrentMap[K, V]) Range(f func(k K, v V) bool) {
+	for k, v := range m.Dict() {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+func (m *concurrentMap[K, V]) Set(k K, v V) {
+	m.mu.Lock()
+	m.set(k, v)
+	m.mu.Unlock()
+}
+
+func (m *concurrentMap[K, V]) Reset() {
+	m.mu.Lock()
+	m.replace(Dict[K, V]{})
+	m.mu.Unlock()
+}
+
+func (m *concurrentMap[K, V]) Update(k K, f func(old V, ok bool) V) (v V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	old, ok := m.values[k]
+	v = f(old, ok)
+	m.set(k, v)
+	return
+}
+
+func (m *concurrentMap[K, V]) Watch(matcher Matcher, opts ...WatchOption) *Subscription {
+	return m.hub.watch(matcher, opts)
+}
+
+func (m *concurrentMap[K, V]) Snapshot(w io.Writer, format SnapshotFormat) error {
+	return writeSnapshot(w, dictKind[K, V](), format, m.Dict())
+}
+
+// Restore replaces the values, JSON numbers held by a map of interfaces are
+// converted like in the snapshots of TSafeMap.
+func (m *concurrentMap[K, V]) Restore(r io.Reader) error {
+	values := Dict[K, V]{}
+	if err := readSnapshot(r, dictKind[K, V](), &values); err != nil {
+		return err
+	}
+	if values == nil {
+		values = Dict[K, V]{}
+	}
+	for k, v := range values {
+		if e, ok := fromJSONValue(v).(V); ok {
+			values[k] = e
+		}
+	}
+
+	m.mu.Lock()
+	m.replace(values)
+	m.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncMapOf(t *testing.T) {
+	m := SyncMapOf[int, string]()
+	m.Set(1, "a")
+	m.Add(1, "b")
+	m.Add(2, "b")
+	assert.Equal(t, 2, m.Len())
+
+	v, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+
+	keys := m.Keys()
+	sort.Ints(keys)
+	assert.Equal(t, []int{1, 2}, keys)
+
+	k, _, ok := m.Find(func(_ int, v string) bool { return v == "b" })
+	assert.True(t, ok)
+	assert.Equal(t, 2, k)
+	assert.Equal(t, Dict[int, string]{1: "a"}, m.FindAll(func(k int, _ string) bool { return k == 1 }))
+	assert.Equal(t, Map{"1": "a", "2": "b"}, m.Map())
+
+	v, loaded := m.GetOrSet(3, "c")
+	assert.False(t, loaded)
+	assert.Equal(t, "c", v)
+	v, loaded = m.GetOrSet(3, "d")
+	assert.True(t, loaded)
+	assert.Equal(t, "c", v)
+
+	assert.False(t, m.CompareAndSwap(3, "x", "d"))
+	assert.True(t, m.CompareAndSwap(3, "c", "d"))
+
+	v, ok = m.LoadAndDelete(3)
+	assert.True(t, ok)
+	assert.Equal(t, "d", v)
+	assert.True(t, m.Delete(2))
+	assert.False(t, m.Delete(2))
+
+	n := 0
+	m.Range(func(int, string) bool {
+		n++
+		return true
+	})
+	assert.Equal(t, 1, n)
+
+	d := m.Dict()
+	d.Set(5, "e")
+	assert.Equal(t, 1, m.Len())
+
+	m.Reset()
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSyncMapFrom(t *testing.T) {
+	d, err := DictFrom[string, int](Map{"hits": 0})
+	assert.NoError(t, err)
+
+	m := SyncMapFrom(d)
+	d.Set("hits", 10)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Update("hits", func(old int, _ bool) int { return old + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+
+	v, _ := m.Get("hits")
+	assert.Equal(t, 5000, v)
+	assert.Equal(t, 5000, m.Map().Int("hits"))
+}
+
+func TestSyncMapOf_Watch(t *testing.T) {
+	m := SyncMapOf[int, string]()
+	m.Set(1, "a")
+
+	s := m.Watch(KeyPrefix("1"))
+	m.Set(1, "b")
+	m.Set(2, "x")
+	m.Update(10, func(string, bool) string { return "c" })
+	m.Delete(1)
+	m.Reset()
+
+	assert.Equal(t, []Event{
+		{Type: EventSet, Key: "1", Old: "a", New: "b"},
+		{Type: EventAdd, Key: "10", New: "c"},
+		{Type: EventDelete, Key: "1", Old: "b"},
+		{Type: EventReset, Old: Map{"10": "c"}},
+	}, drain(s))
+	s.Unsubscribe()
+}
+
+func TestSyncMapOf_Snapshot(t *testing.T) {
+	for _, format := range []SnapshotFormat{SnapshotJSON, SnapshotGob} {
+		m := SyncMapOf[int, any]()
+		m.Set(1, 42)
+		m.Set(2, 1.5)
+		m.Set(3, "a")
+
+		b := bytes.NewBuffer([]byte{})
+		assert.NoError(t, m.Snapshot(b, format))
+
+		restored := SyncMapOf[int, any]()
+		restored.Set(4, "old")
+		assert.NoError(t, restored.Restore(bytes.NewReader(b.Bytes())))
+		assert.Equal(t, Dict[int, any]{1: 42, 2: 1.5, 3: "a"}, restored.Dict())
+
+		err := SyncMapOf[string, any]().Restore(bytes.NewReader(b.Bytes()))
+		assert.ErrorIs(t, err, ErrSnapshotKind)
+	}
+}
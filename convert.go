@@ -0,0 +1,371 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrKeyNotFound is returned when a key doesn't exist.
+	ErrKeyNotFound = errors.New("types: key not found")
+
+	// ErrOverflow is returned when a number doesn't fit in the requested type.
+	ErrOverflow = errors.New("types: value out of range")
+)
+
+// ConvertError is returned when a value can't be converted to the requested type.
+type ConvertError struct {
+	// Value is the value that failed to convert.
+	Value any
+
+	// Type is the name of the requested type.
+	Type string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ConvertError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("types: cannot convert %v (%T) to %s: %v", e.Value, e.Value, e.Type, e.Err)
+	}
+	return fmt.Sprintf("types: cannot convert %v (%T) to %s", e.Value, e.Value, e.Type)
+}
+
+// Unwrap returns the underlying error.
+func (e *ConvertError) Unwrap() error {
+	return e.Err
+}
+
+// ToInt64 converts "v" to int64, numeric strings and json.Number are accepted.
+func ToInt64(v any) (int64, error) {
+	return toInt(v, "int64", math.MinInt64, math.MaxInt64)
+}
+
+// ToInt converts "v" to int, see ToInt64.
+func ToInt(v any) (int, error) {
+	n, err := toInt(v, "int", math.MinInt, math.MaxInt)
+	return int(n), err
+}
+
+// ToUint64 converts "v" to uint64, numeric strings and json.Number are accepted.
+func ToUint64(v any) (uint64, error) {
+	return toUint(v, "uint64", math.MaxUint64)
+}
+
+// ToUint converts "v" to uint, see ToUint64.
+func ToUint(v any) (uint, error) {
+	n, err := toUint(v, "uint", math.MaxUint)
+	return uint(n), err
+}
+
+// ToFloat64 converts "v" to float64, numeric strings and json.Number are accepted.
+func ToFloat64(v any) (float64, error) {
+	switch t := v.(type) {
+	case json.Number:
+		return parseFloat(string(t), v)
+	case string:
+		return parseFloat(t, v)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	}
+	return 0, &ConvertError{Value: v, Type: "float64"}
+}
+
+// ToBool converts "v" to bool, strings accepted by strconv.ParseBool and
+// the numbers 0 and 1 are accepted.
+func ToBool(v any) (bool, error) {
+	switch t := v.(type) {
+	case bool:
+		return t, nil
+	case string:
+		b, err := strconv.ParseBool(t)
+		if err != nil {
+			return false, &ConvertError{Value: v, Type: "bool"}
+		}
+		return b, nil
+	}
+
+	if n, err := ToInt64(v); err == nil && (n == 0 || n == 1) {
+		return n == 1, nil
+	}
+	return false, &ConvertError{Value: v, Type: "bool"}
+}
+
+// ToString converts "v" to string, numbers and booleans are formatted.
+// Nil pointers can't be converted.
+func ToString(v any) (string, error) {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return "", &ConvertError{Value: v, Type: "string"}
+	}
+
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case []byte:
+		return string(t), nil
+	case json.Number:
+		return string(t), nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case fmt.Stringer:
+		return t.String(), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	}
+	return "", &ConvertError{Value: v, Type: "string"}
+}
+
+// ToTime converts "v" to time.Time, strings and Unix timestamps are parsed
+// with ParseTimeLenient in UTC.
+func ToTime(v any) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case *time.Time:
+		if t != nil {
+			return *t, nil
+		}
+	case Date:
+		return t.Time, nil
+	case NullDate:
+		if t.Valid {
+			return t.Time, nil
+		}
+	case NullDateTime:
+		if t.Valid {
+			return t.Time, nil
+		}
+	case DateTime:
+		return t.Time, nil
+	case DateYearMonth:
+		return t.Time, nil
+	case string:
+		return ParseTimeLenient(t, time.UTC)
+	default:
+		if n, err := ToInt64(v); err == nil {
+			return time.Unix(n, 0).UTC(), nil
+		}
+	}
+	return time.Time{}, &ConvertError{Value: v, Type: "time.Time"}
+}
+
+// ToDate converts "v" to Date, see ToTime.
+func ToDate(v any) (Date, error) {
+	if s, ok := v.(string); ok {
+		if d, err := ParseDateE(s); err == nil {
+			return d, nil
+		}
+	}
+	t, err := ToTime(v)
+	return NewDate(t), err
+}
+
+// ToDateTime converts "v" to DateTime, strings in DateTimeFormat are parsed
+// in DateTimeLocation, see ToTime for the other values.
+func ToDateTime(v any) (DateTime, error) {
+	if s, ok := v.(string); ok {
+		if d, err := ParseDateTimeE(s); err == nil {
+			return d, nil
+		}
+	}
+	t, err := ToTime(v)
+	return NewDateTime(t), err
+}
+
+// ToStrings converts "v" to Strings, every element is converted with ToString.
+func ToStrings(v any) (Strings, error) {
+	switch t := v.(type) {
+	case Strings:
+		return t, nil
+	case []string:
+		return Strings(t), nil
+	case string:
+		return Strings{t}, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, &ConvertError{Value: v, Type: "Strings"}
+	}
+
+	out := make(Strings, rv.Len())
+	for i := range out {
+		s, err := ToString(rv.Index(i).Interface())
+		if err != nil {
+			return nil, &ConvertError{Value: v, Type: "Strings", Err: err}
+		}
+		out[i] = s
+	}
+	return out, nil
+}
+
+// ToMap converts "v" to Map.
+func ToMap(v any) (Map, error) {
+	switch t := v.(type) {
+	case Map:
+		return t, nil
+	case map[string]any:
+		return Map(t), nil
+	}
+	return nil, &ConvertError{Value: v, Type: "Map"}
+}
+
+func toInt(v any, name string, min, max int64) (int64, error) {
+	var n int64
+	switch t := v.(type) {
+	case json.Number:
+		return parseInt(string(t), v, name, min, max)
+	case string:
+		return parseInt(t, v, name, min, max)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if rv.Uint() > uint64(max) {
+			return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+		}
+		n = int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return floatToInt(rv.Float(), v, name, min, max)
+	default:
+		return 0, &ConvertError{Value: v, Type: name}
+	}
+
+	if n < min || n > max {
+		return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+	}
+	return n, nil
+}
+
+func toUint(v any, name string, max uint64) (uint64, error) {
+	var n uint64
+	switch t := v.(type) {
+	case json.Number:
+		return parseUint(string(t), v, name, max)
+	case string:
+		return parseUint(t, v, name, max)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+		}
+		n = uint64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n = rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		return floatToUint(rv.Float(), v, name, max)
+	default:
+		return 0, &ConvertError{Value: v, Type: name}
+	}
+
+	if n > max {
+		return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+	}
+	return n, nil
+}
+
+func parseInt(s string, v any, name string, min, max int64) (int64, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		if n < min || n > max {
+			return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+		}
+		return n, nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, &ConvertError{Value: v, Type: name}
+	}
+	return floatToInt(f, v, name, min, max)
+}
+
+func parseUint(s string, v any, name string, max uint64) (uint64, error) {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		if n > max {
+			return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+		}
+		return n, nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, &ConvertError{Value: v, Type: name}
+	}
+	return floatToUint(f, v, name, max)
+}
+
+func parseFloat(s string, v any) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, &ConvertError{Value: v, Type: "float64", Err: ErrOverflow}
+	} else if err != nil {
+		return 0, &ConvertError{Value: v, Type: "float64"}
+	}
+	return f, nil
+}
+
+func floatToInt(f float64, v any, name string, min, max int64) (int64, error) {
+	if f != math.Trunc(f) {
+		return 0, &ConvertError{Value: v, Type: name}
+	}
+	// float64(max)+1 is the first power of two out of range, even when
+	// float64(max) has been rounded up.
+	if f < float64(min) || f >= float64(max)+1 {
+		return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+	}
+	return int64(f), nil
+}
+
+func floatToUint(f float64, v any, name string, max uint64) (uint64, error) {
+	if f != math.Trunc(f) {
+		return 0, &ConvertError{Value: v, Type: name}
+	}
+	if f < 0 || f >= float64(max)+1 {
+		return 0, &ConvertError{Value: v, Type: name, Err: ErrOverflow}
+	}
+	return uint64(f), nil
+}
@@ -0,0 +1,491 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTag is the struct tag used by Decode and MapFrom.
+const DefaultTag = "json"
+
+// FieldError is the failure of a single field.
+type FieldError struct {
+	// Path is the dotted path of the field.
+	Path string
+
+	// Err is the cause of the failure.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError lists every field that failed to decode.
+type DecodeError struct {
+	Errors []*FieldError
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("types: %d field(s) failed to decode: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of every field.
+func (e *DecodeError) Unwrap() []error {
+	out := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		out[i] = err
+	}
+	return out
+}
+
+// Decode fills the struct pointed by "out" with the values of the map,
+// fields are matched with their json tag.
+func (m Map) Decode(out any) error {
+	return m.DecodeTag(out, DefaultTag)
+}
+
+// DecodeTag is like Decode with fields matched with the "tag" tag.
+func (m Map) DecodeTag(out any, tag string) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("types: Decode expects a non-nil pointer, got %T", out)
+	}
+
+	d := decoder{tag: tag}
+	d.decode("", m, rv.Elem())
+	if len(d.errs) > 0 {
+		return &DecodeError{d.errs}
+	}
+	return nil
+}
+
+// MapFrom converts the struct "v" into a Map, fields are named with their json tag.
+func MapFrom(v any) (Map, error) {
+	return MapFromTag(v, DefaultTag)
+}
+
+// MapFromTag is like MapFrom with fields named with the "tag" tag.
+func MapFromTag(v any, tag string) (Map, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("types: MapFrom expects a struct, got %T", v)
+	}
+
+	out := Map{}
+	encodeStruct(rv, tag, out)
+	return out, nil
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	dateType          = reflect.TypeOf(Date{})
+	dateTimeType      = reflect.TypeOf(DateTime{})
+	dateYearMonthType = reflect.TypeOf(DateYearMonth{})
+	mapType           = reflect.TypeOf(Map{})
+	textUnmarshaler   = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// field is an exported struct field with its tag.
+type field struct {
+	name      string
+	index     int
+	omitEmpty bool
+	inline    bool
+}
+
+// fields returns the fields of the struct type "t" for the tag "tag".
+func fields(t reflect.Type, tag string) (out []field) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct && !isLeafType(ft) {
+			out = append(out, field{index: i, inline: true})
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		out = append(out, field{
+			name:      name,
+			index:     i,
+			omitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+		})
+	}
+	return
+}
+
+// isLeafType says if the struct type "t" is converted as a single value.
+func isLeafType(t reflect.Type) bool {
+	switch t {
+	case timeType, dateType, dateTimeType, dateYearMonthType:
+		return true
+	}
+	return reflect.PointerTo(t).Implements(textUnmarshaler)
+}
+
+type decoder struct {
+	tag  string
+	errs []*FieldError
+}
+
+func (d *decoder) fail(path string, err error) {
+	d.errs = append(d.errs, &FieldError{path, err})
+}
+
+func (d *decoder) decode(path string, src any, dst reflect.Value) {
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return
+	}
+
+	if dst.Kind() == reflect.Pointer {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		d.decode(path, src, dst.Elem())
+		return
+	}
+
+	if ok, err := decodeSpecial(src, dst); ok {
+		if err != nil {
+			d.fail(path, err)
+		}
+		return
+	}
+
+	var err error
+	switch dst.Kind() {
+	case reflect.Interface:
+		if reflect.TypeOf(src).AssignableTo(dst.Type()) {
+			dst.Set(reflect.ValueOf(src))
+		} else {
+			err = &ConvertError{Value: src, Type: dst.Type().String()}
+		}
+	case reflect.String:
+		var s string
+		if s, err = ToString(src); err == nil {
+			dst.SetString(s)
+		}
+	case reflect.Bool:
+		var b bool
+		if b, err = ToBool(src); err == nil {
+			dst.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if n, err = ToInt64(src); err == nil {
+			if dst.OverflowInt(n) {
+				err = &ConvertError{Value: src, Type: dst.Type().String(), Err: ErrOverflow}
+			} else {
+				dst.SetInt(n)
+			}
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		if n, err = ToUint64(src); err == nil {
+			if dst.OverflowUint(n) {
+				err = &ConvertError{Value: src, Type: dst.Type().String(), Err: ErrOverflow}
+			} else {
+				dst.SetUint(n)
+			}
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = ToFloat64(src); err == nil {
+			if dst.OverflowFloat(f) {
+				err = &ConvertError{Value: src, Type: dst.Type().String(), Err: ErrOverflow}
+			} else {
+				dst.SetFloat(f)
+			}
+		}
+	case reflect.Slice:
+		d.decodeSlice(path, src, dst)
+	case reflect.Map:
+		d.decodeMap(path, src, dst)
+	case reflect.Struct:
+		d.decodeStruct(path, src, dst)
+	default:
+		err = &ConvertError{Value: src, Type: dst.Type().String()}
+	}
+
+	if err != nil {
+		d.fail(path, err)
+	}
+}
+
+// decodeSpecial decodes the types of this package and the time values,
+// it says if "dst" has been handled.
+func decodeSpecial(src any, dst reflect.Value) (bool, error) {
+	var err error
+	switch p := dst.Addr().Interface().(type) {
+	case *time.Time:
+		*p, err = ToTime(src)
+	case *Date:
+		*p, err = ToDate(src)
+	case *DateTime:
+		*p, err = ToDateTime(src)
+	case *DateYearMonth:
+		var t time.Time
+		if t, err = ToTime(src); err == nil {
+			*p = NewDateYearMonth(t)
+		} else if s, ok := src.(string); ok {
+			*p, err = ParseDateYearMonthE(s)
+		}
+	case *NullDate:
+		p.Date, err = ToDate(src)
+		p.Valid = err == nil
+	case *NullDateTime:
+		p.DateTime, err = ToDateTime(src)
+		p.Valid = err == nil
+	case *Point:
+		if isSlice(src) {
+			var fs Floats
+			fs, err = toFloats(src)
+			if err == nil && len(fs) != 2 {
+				err = &ConvertError{Value: src, Type: "Point"}
+			}
+			if err == nil {
+				*p = Point{fs[0], fs[1]}
+			}
+			return true, err
+		}
+		return false, nil
+	case encoding.TextUnmarshaler:
+		s, ok := src.(string)
+		if !ok {
+			return false, nil
+		}
+		err = p.UnmarshalText([]byte(s))
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (d *decoder) decodeSlice(path string, src any, dst reflect.Value) {
+	rv := reflect.ValueOf(src)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		d.fail(path, &ConvertError{Value: src, Type: dst.Type().String()})
+		return
+	}
+
+	out := reflect.MakeSlice(dst.Type(), rv.Len(), rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		d.decode(path+"["+strconv.Itoa(i)+"]", rv.Index(i).Interface(), out.Index(i))
+	}
+	dst.Set(out)
+}
+
+func (d *decoder) decodeMap(path string, src any, dst reflect.Value) {
+	m, err := ToMap(src)
+	if err != nil {
+		d.fail(path, &ConvertError{Value: src, Type: dst.Type().String()})
+		return
+	}
+
+	out := reflect.MakeMapWithSize(dst.Type(), len(m))
+	for _, k := range sortedKeys(m) {
+		key, ok := d.decodeKey(joinPath(path, k), k, dst.Type().Key())
+		if !ok {
+			continue
+		}
+
+		e := reflect.New(dst.Type().Elem()).Elem()
+		d.decode(joinPath(path, k), m[k], e)
+		out.SetMapIndex(key, e)
+	}
+	dst.Set(out)
+}
+
+// decodeKey converts the key "k" into the type "t", like "1" into an int.
+func (d *decoder) decodeKey(path, k string, t reflect.Type) (reflect.Value, bool) {
+	if t.Kind() == reflect.String {
+		return reflect.ValueOf(k).Convert(t), true
+	}
+
+	key, n := reflect.New(t).Elem(), len(d.errs)
+	d.decode(path, k, key)
+	return key, len(d.errs) == n
+}
+
+func (d *decoder) decodeStruct(path string, src any, dst reflect.Value) {
+	m, err := ToMap(src)
+	if err != nil {
+		d.fail(path, &ConvertError{Value: src, Type: dst.Type().String()})
+		return
+	}
+
+	for _, f := range fields(dst.Type(), d.tag) {
+		fv := dst.Field(f.index)
+		if f.inline {
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					if !fv.CanSet() {
+						continue
+					}
+					fv.Set(reflect.New(fv.Type().Elem()))
+				}
+				fv = fv.Elem()
+			}
+			d.decodeStruct(path, m, fv)
+			continue
+		}
+
+		v, ok := m[f.name]
+		if !ok {
+			if v, ok = lookupFold(m, f.name); !ok {
+				continue
+			}
+		}
+		d.decode(joinPath(path, f.name), v, fv)
+	}
+}
+
+// lookupFold finds the key matching "name" case-insensitively.
+func lookupFold(m Map, name string) (any, bool) {
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func joinPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+	return path + "." + k
+}
+
+func toFloats(v any) (Floats, error) {
+	rv := reflect.ValueOf(v)
+	out := make(Floats, rv.Len())
+	for i := range out {
+		f, err := ToFloat64(rv.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		out[i] = f
+	}
+	return out, nil
+}
+
+func isSlice(v any) bool {
+	k := reflect.ValueOf(v).Kind()
+	return k == reflect.Slice || k == reflect.Array
+}
+
+func encodeStruct(rv reflect.Value, tag string, out Map) {
+	for _, f := range fields(rv.Type(), tag) {
+		fv := rv.Field(f.index)
+		if f.inline {
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			encodeStruct(fv, tag, out)
+			continue
+		}
+
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		out[f.name] = encodeValue(fv, tag)
+	}
+}
+
+func encodeValue(rv reflect.Value, tag string) any {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil
+		}
+		return encodeValue(rv.Elem(), tag)
+	case reflect.Struct:
+		if isLeafType(rv.Type()) {
+			return rv.Interface()
+		}
+		out := Map{}
+		encodeStruct(rv, tag, out)
+		return out
+	case reflect.Map:
+		if rv.Type() == mapType || rv.Type().Key().Kind() != reflect.String || !hasStructs(rv.Type().Elem()) {
+			return rv.Interface()
+		}
+		out := Map{}
+		iter := rv.MapRange()
+		for iter.Next() {
+			out[iter.Key().String()] = encodeValue(iter.Value(), tag)
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if !hasStructs(rv.Type().Elem()) {
+			return rv.Interface()
+		}
+		out := make([]any, rv.Len())
+		for i := range out {
+			out[i] = encodeValue(rv.Index(i), tag)
+		}
+		return out
+	}
+	return rv.Interface()
+}
+
+// hasStructs says if the values of type "t" are converted to Map.
+func hasStructs(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Interface || (t.Kind() == reflect.Struct && !isLeafType(t))
+}
+
+// isEmptyValue follows the omitempty rules of encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type decodeBase struct {
+	ID      uint64   `json:"id"`
+	Created DateTime `json:"created"`
+}
+
+type decodeUser struct {
+	decodeBase
+	Name     string         `json:"name"`
+	Age      int8           `json:"age,omitempty"`
+	Score    float64        `json:"score"`
+	Active   bool           `json:"active"`
+	Birth    Date           `json:"birth"`
+	Seen     *time.Time     `json:"seen,omitempty"`
+	Home     Point          `json:"home"`
+	Work     *Point         `json:"work,omitempty"`
+	Tags     Strings        `json:"tags"`
+	Friends  Ints           `json:"friends,omitempty"`
+	Extra    Map            `json:"extra,omitempty"`
+	Children []decodeBase   `json:"children,omitempty"`
+	Skipped  string         `json:"-"`
+	Labels   map[string]int `json:"labels,omitempty"`
+}
+
+func TestMap_Decode(t *testing.T) {
+	m := Map{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"id": 12,
+		"created": "2020-05-17 13:04:05",
+		"NAME": "John",
+		"age": "42",
+		"score": 1.5,
+		"active": "true",
+		"birth": "1990-01-02",
+		"seen": "2020-05-17T13:04:05Z",
+		"home": {"lat": 48.85, "lng": 2.35},
+		"work": [48.86, 2.33],
+		"tags": ["a", "b"],
+		"friends": [1, 2, 3],
+		"extra": {"k": "v"},
+		"children": [{"id": 1}],
+		"labels": {"x": "3"}
+	}`), &m))
+
+	u := decodeUser{Skipped: "keep"}
+	assert.NoError(t, m.Decode(&u))
+
+	assert.Equal(t, uint64(12), u.ID)
+	assert.Equal(t, 13, u.Created.Hour())
+	assert.Equal(t, "John", u.Name)
+	assert.Equal(t, int8(42), u.Age)
+	assert.True(t, u.Active)
+	assert.Equal(t, 1990, u.Birth.Year())
+	assert.Equal(t, 2020, u.Seen.Year())
+	assert.Equal(t, Point{48.85, 2.35}, u.Home)
+	assert.Equal(t, &Point{48.86, 2.33}, u.Work)
+	assert.Equal(t, Strings{"a", "b"}, u.Tags)
+	assert.Equal(t, Ints{1, 2, 3}, u.Friends)
+	assert.Equal(t, "v", u.Extra.String("k"))
+	assert.Equal(t, uint64(1), u.Children[0].ID)
+	assert.Equal(t, map[string]int{"x": 3}, u.Labels)
+	assert.Equal(t, "keep", u.Skipped)
+}
+
+func TestMap_DecodeErrors(t *testing.T) {
+	m := Map{
+		"id":      -1,
+		"age":     300,
+		"birth":   "yesterday",
+		"friends": []any{1, "x"},
+	}
+
+	err := m.Decode(&decodeUser{})
+	derr := &DecodeError{}
+	assert.True(t, errors.As(err, &derr))
+
+	paths := Strings{}
+	for _, e := range derr.Errors {
+		paths.Add(e.Path)
+	}
+	assert.ElementsMatch(t, Strings{"id", "age", "birth", "friends[1]"}, paths)
+	assert.True(t, errors.Is(err, ErrOverflow))
+
+	assert.Error(t, m.Decode(decodeUser{}))
+}
+
+func TestMap_DecodeTag(t *testing.T) {
+	type config struct {
+		Host string `env:"host"`
+		Port int    `env:"port"`
+	}
+
+	c := config{}
+	assert.NoError(t, Map{"host": "localhost", "port": "5432"}.DecodeTag(&c, "env"))
+	assert.Equal(t, config{"localhost", 5432}, c)
+}
+
+func TestMapFrom(t *testing.T) {
+	u := decodeUser{
+		decodeBase: decodeBase{ID: 1},
+		Name:       "John",
+		Home:       Point{1, 2},
+		Tags:       Strings{"a"},
+		Children:   []decodeBase{{ID: 2}},
+		Extra:      Map{"k": "v"},
+	}
+
+	m, err := MapFrom(&u)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(1), m["id"])
+	assert.Equal(t, DateTime{}, m["created"])
+	assert.Equal(t, "John", m["name"])
+	assert.Equal(t, Map{"lat": float64(1), "lng": float64(2)}, m["home"])
+	assert.Equal(t, Strings{"a"}, m["tags"])
+	assert.Equal(t, []any{Map{"id": uint64(2), "created": DateTime{}}}, m["children"])
+	assert.Equal(t, Map{"k": "v"}, m["extra"])
+	assert.False(t, m.KeyExists("age"))
+	assert.False(t, m.KeyExists("work"))
+	assert.False(t, m.KeyExists("Skipped"))
+
+	u2 := decodeUser{}
+	assert.NoError(t, m.Decode(&u2))
+	assert.Equal(t, u, u2)
+
+	_, err = MapFrom(12)
+	assert.Error(t, err)
+}
@@ -0,0 +1,149 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Dict is a typed hashmap.
+type Dict[K comparable, V any] map[K]V
+
+// DictFrom converts the Map "m" into a Dict. Keys and values are converted
+// like with Decode, so that the key "1" becomes an int and the JSON number
+// 1.0 an int value. Nil values become the zero value of V. Every failure is
+// returned in a *DecodeError.
+func DictFrom[K comparable, V any](m Map) (Dict[K, V], error) {
+	out := Dict[K, V]{}
+	d := decoder{tag: DefaultTag}
+	d.decodeMap("", m, reflect.ValueOf(&out).Elem())
+	if len(d.errs) > 0 {
+		return nil, &DecodeError{d.errs}
+	}
+	return out, nil
+}
+
+// Reset the values of the map.
+func (d *Dict[K, V]) Reset() {
+	*d = Dict[K, V]{}
+}
+
+// Add a value to the map if the key doesn't exists.
+func (d Dict[K, V]) Add(k K, v V) {
+	if _, ok := d[k]; !ok {
+		d[k] = v
+	}
+}
+
+// Merge another map.
+func (d Dict[K, V]) Merge(sub map[K]V) {
+	for k, v := range sub {
+		d.Add(k, v)
+	}
+}
+
+// Copy the keys and values into a new map.
+func (d Dict[K, V]) Copy() Dict[K, V] {
+	out := make(Dict[K, V], len(d))
+	for k, v := range d {
+		out[k] = v
+	}
+	return out
+}
+
+// Delete the key "k" and say if it existed.
+func (d Dict[K, V]) Delete(k K) bool {
+	_, ok := d[k]
+	delete(d, k)
+	return ok
+}
+
+// Find the first element matching the pattern.
+func (d Dict[K, V]) Find(matcher func(k K, v V) bool) (K, V, bool) {
+	for k, v := range d {
+		if matcher(k, v) {
+			return k, v, true
+		}
+	}
+
+	var (
+		k K
+		v V
+	)
+	return k, v, false
+}
+
+// FindAll elements matching the pattern.
+func (d Dict[K, V]) FindAll(matcher func(k K, v V) bool) Dict[K, V] {
+	out := Dict[K, V]{}
+	for k, v := range d {
+		if matcher(k, v) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Get an element from the map.
+func (d Dict[K, V]) Get(k K) (v V, ok bool) {
+	v, ok = d[k]
+	return
+}
+
+// KeyExists says if the list of keys exists.
+func (d Dict[K, V]) KeyExists(keys ...K) bool {
+	for _, k := range keys {
+		if _, ok := d[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys return the list of keys.
+func (d Dict[K, V]) Keys() []K {
+	out := make([]K, 0, len(d))
+	for k := range d {
+		out = append(out, k)
+	}
+	return out
+}
+
+// Len returns the size of the map.
+func (d Dict[K, V]) Len() int {
+	return len(d)
+}
+
+// Set a new value in the map.
+func (d Dict[K, V]) Set(k K, v V) {
+	d[k] = v
+}
+
+// Values return the list of values.
+func (d Dict[K, V]) Values() []V {
+	out := make([]V, 0, len(d))
+	for _, v := range d {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Map converts the Dict into a Map, keys are converted with ToString.
+func (d Dict[K, V]) Map() Map {
+	out := make(Map, len(d))
+	for k, v := range d {
+		out[keyString(k)] = v
+	}
+	return out
+}
+
+func keyString(k any) string {
+	if s, err := ToString(k); err == nil {
+		return s
+	}
+	return fmt.Sprint(k)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDict(t *testing.T) {
+	d := Dict[int, string]{1: "a"}
+	d.Add(1, "b")
+	d.Set(2, "b")
+	d.Merge(map[int]string{2: "c", 3: "c"})
+	assert.Equal(t, Dict[int, string]{1: "a", 2: "b", 3: "c"}, d)
+	assert.Equal(t, 3, d.Len())
+	assert.True(t, d.KeyExists(1, 2))
+	assert.False(t, d.KeyExists(1, 4))
+
+	keys := d.Keys()
+	sort.Ints(keys)
+	assert.Equal(t, []int{1, 2, 3}, keys)
+
+	values := d.Values()
+	sort.Strings(values)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+
+	k, v, ok := d.Find(func(_ int, v string) bool { return v == "b" })
+	assert.True(t, ok)
+	assert.Equal(t, 2, k)
+	assert.Equal(t, "b", v)
+
+	_, _, ok = d.Find(func(int, string) bool { return false })
+	assert.False(t, ok)
+	assert.Equal(t, Dict[int, string]{1: "a"}, d.FindAll(func(k int, _ string) bool { return k < 2 }))
+
+	c := d.Copy()
+	assert.True(t, c.Delete(1))
+	assert.False(t, c.Delete(1))
+	assert.Equal(t, 3, d.Len())
+
+	v, ok = d.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "c", v)
+
+	d.Reset()
+	assert.Equal(t, 0, d.Len())
+}
+
+func TestDict_Map(t *testing.T) {
+	m := Dict[int, float64]{1: 1.5, 2: 2}.Map()
+	assert.Equal(t, Map{"1": 1.5, "2": 2.0}, m)
+	assert.Equal(t, 1.5, m.Float64("1"))
+
+	d, err := DictFrom[string, int](Map{"a": 1, "b": nil, "c": 1.0, "d": "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, Dict[string, int]{"a": 1, "b": 0, "c": 1, "d": 2}, d)
+
+	// Keys are converted too, the reverse of Map.
+	d2, err := DictFrom[int, float64](m)
+	assert.NoError(t, err)
+	assert.Equal(t, Dict[int, float64]{1: 1.5, 2: 2}, d2)
+
+	d3, err := DictFrom[uint64, Strings](Map{"7": []any{"a", "b"}})
+	assert.NoError(t, err)
+	assert.Equal(t, Dict[uint64, Strings]{7: {"a", "b"}}, d3)
+
+	_, err = DictFrom[int, int](Map{"a": 1, "2": 1.5, "3": "x"})
+	var derr *DecodeError
+	assert.ErrorAs(t, err, &derr)
+	assert.Len(t, derr.Errors, 3)
+	assert.Equal(t, "2", derr.Errors[0].Path)
+	assert.Equal(t, "a", derr.Errors[2].Path)
+}
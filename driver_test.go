@@ -0,0 +1,90 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver is a database/sql driver returning a single row holding the
+// value registered for the query.
+type fakeDriver struct{}
+
+var fakeValues = map[string]driver.Value{}
+
+func init() {
+	sql.Register("types-fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt(query), nil
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+type fakeStmt string
+
+func (fakeStmt) Close() error {
+	return nil
+}
+
+func (fakeStmt) NumInput() int {
+	return 0
+}
+
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.ResultNoRows, nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{value: fakeValues[string(s)]}, nil
+}
+
+type fakeRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (*fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (*fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+// fakeScan scans "v" into "dest" through the fake driver.
+func fakeScan(t *testing.T, v driver.Value, dest any) error {
+	t.Helper()
+
+	db, err := sql.Open("types-fake", "")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	fakeValues[t.Name()] = v
+	return db.QueryRow(t.Name()).Scan(dest)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// DefaultEnvSeparator separates the nested keys of MapFromEnv.
+const DefaultEnvSeparator = "__"
+
+// EnvOption configures MapFromEnv.
+type EnvOption func(*envConfig)
+
+type envConfig struct {
+	environ func() []string
+	sep     string
+	infer   bool
+}
+
+// WithEnviron sets the source of the variables, os.Environ by default.
+func WithEnviron(environ func() []string) EnvOption {
+	return func(c *envConfig) {
+		c.environ = environ
+	}
+}
+
+// WithEnvSeparator sets the separator of the nested keys, DefaultEnvSeparator
+// by default. It can't be empty.
+func WithEnvSeparator(sep string) EnvOption {
+	return func(c *envConfig) {
+		c.sep = sep
+	}
+}
+
+// WithEnvInference converts the values looking like booleans, integers and
+// floats, and splits the values holding commas into Strings.
+func WithEnvInference() EnvOption {
+	return func(c *envConfig) {
+		c.infer = true
+	}
+}
+
+// MapFromEnv builds a Map from the environment variables starting with
+// "prefix". The prefix is removed, keys are lower-cased and nested on the
+// separator, so that "APP_DB__HOST" is set at "db.host" for the prefix "APP_".
+func MapFromEnv(prefix string, opts ...EnvOption) (Map, error) {
+	c := &envConfig{environ: os.Environ, sep: DefaultEnvSeparator}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.sep == "" {
+		return nil, fmt.Errorf("%w: empty separator", ErrInvalidPath)
+	}
+
+	environ := append([]string{}, c.environ()...)
+	sort.Strings(environ)
+
+	out := Map{}
+	for _, kv := range environ {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(name, prefix) || name == prefix {
+			continue
+		}
+
+		parts := strings.Split(strings.ToLower(name[len(prefix):]), c.sep)
+		segs := make([]flatSegment, len(parts))
+		for i, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("%w: empty segment in %q", ErrInvalidPath, name)
+			}
+			segs[i] = flatSegment{key: part}
+		}
+
+		if err := unflattenSet(out, segs, c.value(value)); err != nil {
+			return nil, fmt.Errorf("types: cannot set variable %q: %w", name, err)
+		}
+	}
+	return out, nil
+}
+
+func (c *envConfig) value(s string) any {
+	if !c.infer {
+		return s
+	}
+	if strings.Contains(s, ",") {
+		out := Strings(strings.Split(s, ","))
+		for i, v := range out {
+			out[i] = strings.TrimSpace(v)
+		}
+		return out
+	}
+	return inferValue(s)
+}
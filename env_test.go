@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeEnviron(vars ...string) func() []string {
+	return func() []string {
+		return vars
+	}
+}
+
+func TestMapFromEnv(t *testing.T) {
+	environ := makeEnviron(
+		"APP_DB__HOST=localhost",
+		"APP_DB__PORT=5432",
+		"APP_DB__POOL__SIZE=10",
+		"APP_DEBUG=true",
+		"APP_RATIO=0.5",
+		"APP_HOSTS=a, b,c",
+		"APP_ZIP=0042",
+		"APP_EMPTY=",
+		"APP_=ignored",
+		"OTHER_KEY=1",
+		"APP_DSN=user=root",
+	)
+
+	m, err := MapFromEnv("APP_", WithEnviron(environ))
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		"db":    Map{"host": "localhost", "port": "5432", "pool": Map{"size": "10"}},
+		"debug": "true",
+		"ratio": "0.5",
+		"hosts": "a, b,c",
+		"zip":   "0042",
+		"empty": "",
+		"dsn":   "user=root",
+	}, m)
+	assert.Equal(t, 5432, m.Map("db").Int("port"))
+
+	m, err = MapFromEnv("APP_", WithEnviron(environ), WithEnvInference())
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		"db":    Map{"host": "localhost", "port": 5432, "pool": Map{"size": 10}},
+		"debug": true,
+		"ratio": 0.5,
+		"hosts": Strings{"a", "b", "c"},
+		"zip":   "0042",
+		"empty": "",
+		"dsn":   "user=root",
+	}, m)
+
+	v, err := m.PathE("db.pool.size")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, v)
+}
+
+func TestMapFromEnvSeparator(t *testing.T) {
+	m, err := MapFromEnv("", WithEnviron(makeEnviron("DB_HOST=x", "DB_PORT=1")), WithEnvSeparator("_"))
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"db": Map{"host": "x", "port": "1"}}, m)
+}
+
+func TestMapFromEnvErrors(t *testing.T) {
+	_, err := MapFromEnv("APP_", WithEnviron(makeEnviron("APP_DB=x", "APP_DB__HOST=y")))
+	assert.ErrorIs(t, err, ErrNotContainer)
+
+	_, err = MapFromEnv("APP_", WithEnviron(makeEnviron("APP_DB____HOST=y")))
+	assert.ErrorIs(t, err, ErrInvalidPath)
+
+	_, err = MapFromEnv("APP_", WithEnviron(makeEnviron("APP_DB=x")), WithEnvSeparator(""))
+	assert.ErrorIs(t, err, ErrInvalidPath)
+}
+
+func TestMapFromEnvOS(t *testing.T) {
+	t.Setenv("TYPES_TEST__NAME", "app")
+
+	m, err := MapFromEnv("TYPES_TEST__")
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"name": "app"}, m)
+}
@@ -0,0 +1,318 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// IndexStyle is the way slice indexes are written by Flatten.
+type IndexStyle int
+
+const (
+	// IndexBrackets writes indexes as "items[0]".
+	IndexBrackets IndexStyle = iota
+
+	// IndexSeparator writes indexes as "items.0", numeric keys are then
+	// read back as indexes by Unflatten.
+	IndexSeparator
+)
+
+// MaxIndex is the highest slice index accepted by Unflatten and
+// MapFromValues when the input has fewer keys, so that a key can't allocate
+// a huge slice. The holes of sparse indexes are left nil.
+const MaxIndex = 1000
+
+// FlattenOption configures Flatten and Unflatten.
+type FlattenOption func(*flattenConfig)
+
+type flattenConfig struct {
+	index  IndexStyle
+	escape rune
+}
+
+// WithIndexStyle sets the way slice indexes are written, IndexBrackets by default.
+func WithIndexStyle(style IndexStyle) FlattenOption {
+	return func(c *flattenConfig) {
+		c.index = style
+	}
+}
+
+// WithEscape escapes the separators, brackets and "r" itself found inside
+// keys by prefixing them with "r", so that any key round-trips.
+func WithEscape(r rune) FlattenOption {
+	return func(c *flattenConfig) {
+		c.escape = r
+	}
+}
+
+func newFlattenConfig(opts []FlattenOption) *flattenConfig {
+	c := &flattenConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Flatten returns a flat map whose keys are the paths of the leaves of the
+// map joined with "sep", like "db.pool.size" or "items[0].id". Empty maps
+// and slices are kept as leaves. The separator can't be empty.
+func (m Map) Flatten(sep string, opts ...FlattenOption) (Map, error) {
+	if sep == "" {
+		return nil, fmt.Errorf("%w: empty separator", ErrInvalidPath)
+	}
+
+	c := newFlattenConfig(opts)
+	out := Map{}
+	for k, v := range m {
+		c.flatten(out, sep, c.escapeKey(k, sep), v)
+	}
+	return out, nil
+}
+
+func (c *flattenConfig) flatten(out Map, sep, prefix string, v any) {
+	if m, err := ToMap(v); err == nil && len(m) > 0 {
+		for k, e := range m {
+			c.flatten(out, sep, prefix+sep+c.escapeKey(k, sep), e)
+		}
+		return
+	}
+
+	if _, ok := v.([]byte); !ok && isSlice(v) {
+		if rv := reflect.ValueOf(v); rv.Len() > 0 {
+			for i := 0; i < rv.Len(); i++ {
+				c.flatten(out, sep, c.indexKey(prefix, sep, i), rv.Index(i).Interface())
+			}
+			return
+		}
+	}
+	out[prefix] = v
+}
+
+func (c *flattenConfig) indexKey(prefix, sep string, i int) string {
+	if c.index == IndexSeparator {
+		return prefix + sep + strconv.Itoa(i)
+	}
+	return prefix + "[" + strconv.Itoa(i) + "]"
+}
+
+func (c *flattenConfig) escapeKey(k, sep string) string {
+	if c.escape == 0 {
+		return k
+	}
+
+	esc := string(c.escape)
+	k = strings.NewReplacer(esc, esc+esc, sep, esc+sep, "[", esc+"[").Replace(k)
+	if _, err := strconv.Atoi(k); c.index == IndexSeparator && err == nil {
+		k = esc + k
+	}
+	return k
+}
+
+// flatSegment is a segment of a flat key.
+type flatSegment struct {
+	key   string
+	index int
+	isIdx bool
+}
+
+// parseKey splits a flat key into its segments, it is the reverse of the
+// keys written by flatten.
+func (c *flattenConfig) parseKey(k, sep string) ([]flatSegment, error) {
+	var (
+		out     []flatSegment
+		cur     strings.Builder
+		literal bool // the current segment holds escaped characters
+		closed  bool // the current segment has been closed by an index
+	)
+
+	push := func() {
+		s := cur.String()
+		if i, err := strconv.Atoi(s); c.index == IndexSeparator && !literal && err == nil && i >= 0 {
+			out = append(out, flatSegment{index: i, isIdx: true})
+		} else {
+			out = append(out, flatSegment{key: s})
+		}
+		cur.Reset()
+		literal = false
+	}
+
+	esc := string(c.escape)
+	for i := 0; i < len(k); {
+		switch {
+		case c.escape != 0 && strings.HasPrefix(k[i:], esc):
+			i += len(esc)
+			if i >= len(k) || closed {
+				return nil, fmt.Errorf("%w: unexpected escape in %q", ErrInvalidPath, k)
+			}
+			_, size := utf8.DecodeRuneInString(k[i:])
+			cur.WriteString(k[i : i+size])
+			i += size
+			literal = true
+		case strings.HasPrefix(k[i:], sep):
+			if !closed {
+				push()
+			}
+			closed = false
+			i += len(sep)
+		case c.index == IndexBrackets && k[i] == '[':
+			end := strings.IndexByte(k[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unclosed index in %q", ErrInvalidPath, k)
+			}
+			n, err := strconv.Atoi(k[i+1 : i+end])
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%w: malformed index in %q", ErrInvalidPath, k)
+			}
+			if !closed {
+				push()
+			}
+			out = append(out, flatSegment{index: n, isIdx: true})
+			closed = true
+			i += end + 1
+		default:
+			if closed {
+				return nil, fmt.Errorf("%w: unexpected %q after index in %q", ErrInvalidPath, k[i:], k)
+			}
+			cur.WriteByte(k[i])
+			i++
+		}
+	}
+
+	if !closed {
+		push()
+	}
+	return out, nil
+}
+
+// flatArray collects the elements of a slice while unflattening.
+type flatArray map[int]any
+
+// Unflatten rebuilds the nested maps and slices of a map built by Flatten,
+// it must be called with the same separator and options. Indexes can't be
+// greater than MaxIndex or the number of keys, whichever is higher.
+func (m Map) Unflatten(sep string, opts ...FlattenOption) (Map, error) {
+	if sep == "" {
+		return nil, fmt.Errorf("%w: empty separator", ErrInvalidPath)
+	}
+
+	c := newFlattenConfig(opts)
+	out := Map{}
+
+	keys := sortedKeys(m)
+	for _, k := range keys {
+		segs, err := c.parseKey(k, sep)
+		if err != nil {
+			return nil, err
+		}
+		if segs[0].isIdx {
+			return nil, fmt.Errorf("%w: %q starts with an index", ErrInvalidPath, k)
+		}
+		if err := checkIndexes(segs, len(m)); err != nil {
+			return nil, fmt.Errorf("types: cannot unflatten %q: %w", k, err)
+		}
+		if err := unflattenSet(out, segs, m[k]); err != nil {
+			return nil, fmt.Errorf("types: cannot unflatten %q: %w", k, err)
+		}
+	}
+	return finishUnflatten(out).(Map), nil
+}
+
+// checkIndexes fails when an index of the segments is greater than MaxIndex
+// and isn't lower than the number of keys "n".
+func checkIndexes(segs []flatSegment, n int) error {
+	for _, seg := range segs {
+		if seg.isIdx && seg.index > MaxIndex && seg.index >= n {
+			return fmt.Errorf("%w: %d is greater than %d", ErrIndexOutOfRange, seg.index, MaxIndex)
+		}
+	}
+	return nil
+}
+
+func unflattenSet(node any, segs []flatSegment, v any) error {
+	seg, last := segs[0], len(segs) == 1
+
+	get := func() (any, bool) {
+		switch n := node.(type) {
+		case Map:
+			e, ok := n[seg.key]
+			return e, ok
+		case flatArray:
+			e, ok := n[seg.index]
+			return e, ok
+		}
+		return nil, false
+	}
+	set := func(e any) error {
+		switch n := node.(type) {
+		case Map:
+			if seg.isIdx {
+				return ErrNotContainer
+			}
+			n[seg.key] = e
+		case flatArray:
+			if !seg.isIdx {
+				return ErrNotContainer
+			}
+			n[seg.index] = e
+		}
+		return nil
+	}
+
+	if last {
+		if _, ok := get(); ok {
+			return ErrNotContainer
+		}
+		return set(v)
+	}
+
+	next, ok := get()
+	if !ok {
+		if segs[1].isIdx {
+			next = flatArray{}
+		} else {
+			next = Map{}
+		}
+		if err := set(next); err != nil {
+			return err
+		}
+	}
+
+	switch next.(type) {
+	case Map, flatArray:
+		return unflattenSet(next, segs[1:], v)
+	}
+	return ErrNotContainer
+}
+
+// finishUnflatten converts the flatArray into slices, holes are left nil.
+func finishUnflatten(v any) any {
+	switch n := v.(type) {
+	case Map:
+		for k, e := range n {
+			n[k] = finishUnflatten(e)
+		}
+		return n
+	case flatArray:
+		indexes := make([]int, 0, len(n))
+		for i := range n {
+			indexes = append(indexes, i)
+		}
+		sort.Ints(indexes)
+
+		out := make([]any, indexes[len(indexes)-1]+1)
+		for _, i := range indexes {
+			out[i] = finishUnflatten(n[i])
+		}
+		return out
+	}
+	return v
+}
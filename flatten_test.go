@@ -0,0 +1,169 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeFlattenMap() Map {
+	return Map{
+		"db": Map{"host": "localhost", "pool": map[string]any{"size": 5}},
+		"items": []any{
+			Map{"id": 1, "tags": Strings{"a", "b"}},
+			Map{"id": 2},
+		},
+		"empty": Map{},
+		"none":  []any{},
+		"name":  "app",
+	}
+}
+
+func TestMap_Flatten(t *testing.T) {
+	m := makeFlattenMap()
+	flat, err := m.Flatten(".")
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		"db.host":          "localhost",
+		"db.pool.size":     5,
+		"items[0].id":      1,
+		"items[0].tags[0]": "a",
+		"items[0].tags[1]": "b",
+		"items[1].id":      2,
+		"empty":            Map{},
+		"none":             []any{},
+		"name":             "app",
+	}, flat)
+
+	flat, err = m.Flatten("/", WithIndexStyle(IndexSeparator))
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		"db/host":        "localhost",
+		"db/pool/size":   5,
+		"items/0/id":     1,
+		"items/0/tags/0": "a",
+		"items/0/tags/1": "b",
+		"items/1/id":     2,
+		"empty":          Map{},
+		"none":           []any{},
+		"name":           "app",
+	}, flat)
+
+	_, err = m.Flatten("")
+	assert.ErrorIs(t, err, ErrInvalidPath)
+}
+
+func TestMap_Unflatten(t *testing.T) {
+	expected := Map{
+		"db": Map{"host": "localhost", "pool": Map{"size": 5}},
+		"items": []any{
+			Map{"id": 1, "tags": []any{"a", "b"}},
+			Map{"id": 2},
+		},
+		"empty": Map{},
+		"none":  []any{},
+		"name":  "app",
+	}
+
+	m := makeFlattenMap()
+	for _, opts := range [][]FlattenOption{
+		nil,
+		{WithIndexStyle(IndexSeparator)},
+		{WithEscape('\\')},
+		{WithIndexStyle(IndexSeparator), WithEscape('\\')},
+	} {
+		flat, err := m.Flatten(".", opts...)
+		assert.NoError(t, err)
+		out, err := flat.Unflatten(".", opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, out)
+	}
+
+	// Holes are left nil.
+	out, err := Map{"a[2]": 1}.Unflatten(".")
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"a": []any{nil, nil, 1}}, out)
+
+	// Slices longer than MaxIndex round-trip.
+	long := make([]any, MaxIndex+5)
+	for i := range long {
+		long[i] = i
+	}
+	flat, err := Map{"a": long}.Flatten(".")
+	assert.NoError(t, err)
+	out, err = flat.Unflatten(".")
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"a": long}, out)
+
+	out, err = Map{"a[0][1]": 1, "a[0][0]": 0}.Unflatten(".")
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"a": []any{[]any{0, 1}}}, out)
+}
+
+func TestMap_UnflattenErrors(t *testing.T) {
+	for _, m := range []Map{
+		{"a": 1, "a.b": 2},
+		{"a.b": 1, "a[0]": 2},
+	} {
+		_, err := m.Unflatten(".")
+		assert.ErrorIs(t, err, ErrNotContainer, "%v", m)
+	}
+
+	for _, m := range []Map{
+		{"a[x]": 1},
+		{"a[0": 1},
+		{"a[0]b": 1},
+	} {
+		_, err := m.Unflatten(".")
+		assert.ErrorIs(t, err, ErrInvalidPath, "%v", m)
+	}
+
+	_, err := Map{"0.a": 1}.Unflatten(".", WithIndexStyle(IndexSeparator))
+	assert.ErrorIs(t, err, ErrInvalidPath)
+
+	_, err = Map{"ab": 1}.Unflatten("")
+	assert.ErrorIs(t, err, ErrInvalidPath)
+
+	// Indexes are bounded by MaxIndex.
+	for _, m := range []Map{
+		{"a[30000000]": 1},
+		{"a[1001]": 1},
+	} {
+		_, err := m.Unflatten(".")
+		assert.ErrorIs(t, err, ErrIndexOutOfRange, "%v", m)
+	}
+	_, err = Map{"a.30000000": 1}.Unflatten(".", WithIndexStyle(IndexSeparator))
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestMap_FlattenEscape(t *testing.T) {
+	m := Map{
+		"a.b":  Map{"c": 1},
+		`x\y`:  []any{2},
+		"[0]":  3,
+		"list": Map{"0": 4},
+	}
+
+	flat, err := m.Flatten(".", WithEscape('\\'))
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		`a\.b.c`:  1,
+		`x\\y[0]`: 2,
+		`\[0]`:    3,
+		"list.0":  4,
+	}, flat)
+
+	out, err := flat.Unflatten(".", WithEscape('\\'))
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"a.b": Map{"c": 1}, `x\y`: []any{2}, "[0]": 3, "list": Map{"0": 4}}, out)
+
+	// Numeric keys are escaped when indexes are written with the separator.
+	flat, err = m.Flatten(".", WithEscape('\\'), WithIndexStyle(IndexSeparator))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, flat[`list.\0`])
+	assert.Equal(t, 2, flat[`x\\y.0`])
+
+	out, err = flat.Unflatten(".", WithEscape('\\'), WithIndexStyle(IndexSeparator))
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"a.b": Map{"c": 1}, `x\y`: []any{2}, "[0]": 3, "list": Map{"0": 4}}, out)
+}
@@ -0,0 +1,185 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var (
+	// ErrInvalidSchema is returned when a JSON Schema keyword has a wrong value.
+	ErrInvalidSchema = errors.New("types: invalid JSON Schema")
+
+	// ErrUnsupportedSchema is returned when a JSON Schema keyword isn't supported.
+	ErrUnsupportedSchema = errors.New("types: unsupported JSON Schema keyword")
+)
+
+// schemaAnnotations are the keywords that don't change the validation.
+var schemaAnnotations = Strings{
+	"$schema", "$id", "$comment", "title", "description",
+	"default", "examples", "deprecated", "readOnly", "writeOnly",
+}
+
+// ParseJSONSchema loads a JSON Schema draft 2020-12 document. Only the
+// keywords type, properties, required, additionalProperties (as a boolean),
+// items, enum, const, minimum, maximum, exclusiveMinimum, exclusiveMaximum,
+// minLength, maxLength, minItems, maxItems and pattern are supported, the
+// other keywords are rejected with ErrUnsupportedSchema.
+func ParseJSONSchema(b []byte) (*Schema, error) {
+	var doc any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return schemaFromJSON("#", doc)
+}
+
+func schemaFromJSON(path string, doc any) (*Schema, error) {
+	switch t := doc.(type) {
+	case bool:
+		if t {
+			return NewSchema(), nil
+		}
+		return NewSchema().Check("false", func(string, any) bool { return false }), nil
+	case map[string]any:
+		s := NewSchema()
+		for _, k := range sortedKeys(t) {
+			if err := s.loadKeyword(path, k, t[k]); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("%w: %s is not an object or a boolean", ErrInvalidSchema, path)
+}
+
+func (s *Schema) loadKeyword(path, k string, v any) error {
+	invalid := func() error {
+		return fmt.Errorf("%w: %s/%s", ErrInvalidSchema, path, k)
+	}
+
+	switch k {
+	case "type":
+		names, err := ToStrings(v)
+		if err != nil {
+			return invalid()
+		}
+		for _, name := range names {
+			t, ok := schemaTypeByName(name)
+			if !ok {
+				return invalid()
+			}
+			s.types = append(s.types, t)
+		}
+	case "properties":
+		props, err := ToMap(v)
+		if err != nil {
+			return invalid()
+		}
+		for _, key := range sortedKeys(props) {
+			sub, err := schemaFromJSON(path+"/properties/"+escapePointer(key), props[key])
+			if err != nil {
+				return err
+			}
+			s.Key(key, sub)
+		}
+	case "required":
+		keys, err := ToStrings(v)
+		if err != nil {
+			return invalid()
+		}
+		s.Required(keys...)
+	case "additionalProperties":
+		allowed, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("%w: %s/%s must be a boolean", ErrUnsupportedSchema, path, k)
+		}
+		s.strict = !allowed
+	case "items":
+		sub, err := schemaFromJSON(path+"/items", v)
+		if err != nil {
+			return err
+		}
+		s.Items(sub)
+	case "enum":
+		if !TypeArray.match(v) {
+			return invalid()
+		}
+		s.Enum(v.([]any)...)
+	case "const":
+		s.Const(v)
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+		f, err := ToFloat64(v)
+		if err != nil {
+			return invalid()
+		}
+		s.loadBound(k, f)
+	case "minLength", "minItems", "maxLength", "maxItems":
+		n, err := ToInt(v)
+		if err != nil || n < 0 {
+			return invalid()
+		}
+		switch k {
+		case "minLength":
+			s.MinLen(n)
+		case "maxLength":
+			s.MaxLen(n)
+		case "minItems":
+			s.MinItems(n)
+		case "maxItems":
+			s.MaxItems(n)
+		}
+	case "pattern":
+		expr, ok := v.(string)
+		if !ok {
+			return invalid()
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("%w: %s/%s: %v", ErrInvalidSchema, path, k, err)
+		}
+		s.pattern = re
+	default:
+		if !schemaAnnotations.Contains(k) {
+			return fmt.Errorf("%w %q at %s", ErrUnsupportedSchema, k, path)
+		}
+	}
+	return nil
+}
+
+// loadBound keeps the strictest bound when both the inclusive and the
+// exclusive keywords are given.
+func (s *Schema) loadBound(k string, f float64) {
+	switch k {
+	case "minimum":
+		if s.min == nil || f > *s.min {
+			s.Min(f)
+		}
+	case "exclusiveMinimum":
+		if s.min == nil || f >= *s.min {
+			s.ExclusiveMin(f)
+		}
+	case "maximum":
+		if s.max == nil || f < *s.max {
+			s.Max(f)
+		}
+	case "exclusiveMaximum":
+		if s.max == nil || f <= *s.max {
+			s.ExclusiveMax(f)
+		}
+	}
+}
+
+func schemaTypeByName(name string) (SchemaType, bool) {
+	for t, n := range schemaTypeNames {
+		if n == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,243 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// List is a generic slice of comparable elements.
+type List[T comparable] []T
+
+// Reset the slice.
+func (s *List[T]) Reset() {
+	*s = []T{}
+}
+
+// Add new elements to the slice.
+func (s *List[T]) Add(values ...T) {
+	*s = append(*s, values...)
+}
+
+// Set the element "i" and say if it exists.
+func (s List[T]) Set(i int, v T) bool {
+	if i < 0 || i >= s.Len() {
+		return false
+	}
+	s[i] = v
+	return true
+}
+
+// RemoveAt remove the element "i" and return it.
+func (s *List[T]) RemoveAt(i int) (v T, ok bool) {
+	if v, ok = s.Get(i); ok {
+		*s = append((*s)[:i], (*s)[i+1:]...)
+	}
+	return
+}
+
+// Remove every occurrence of "values" and return the number of removed elements.
+func (s *List[T]) Remove(values ...T) (n int) {
+	out := (*s)[:0]
+	for _, v := range *s {
+		if List[T](values).Contains(v) {
+			n++
+			continue
+		}
+		out = append(out, v)
+	}
+	*s = out
+	return
+}
+
+// Pop remove the last element and return it.
+func (s *List[T]) Pop() (T, bool) {
+	return s.RemoveAt(s.Len() - 1)
+}
+
+// Shift remove the first element and return it.
+func (s *List[T]) Shift() (T, bool) {
+	return s.RemoveAt(0)
+}
+
+// Contains say if "s" contains "values".
+func (s List[T]) Contains(values ...T) bool {
+	findNum := 0
+	for i := range s {
+		for _, value := range values {
+			if s[i] == value {
+				findNum++
+				break
+			}
+		}
+	}
+	return findNum == len(values)
+}
+
+// ContainsOneOf says if "s" contains one of the "values".
+func (s List[T]) ContainsOneOf(values ...T) bool {
+	for _, value := range values {
+		for i := range s {
+			if s[i] == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Copy create a new copy of the slice.
+func (s List[T]) Copy() List[T] {
+	out := make(List[T], s.Len())
+	copy(out, s)
+	return out
+}
+
+// Diff returns the difference between "s" and "s2".
+func (s List[T]) Diff(s2 List[T]) List[T] {
+	if s.Empty() {
+		return s2.Copy()
+	} else if s2.Empty() {
+		return s.Copy()
+	}
+
+	out := List[T]{}
+
+	if len(s) >= len(s2) {
+		for _, v := range s {
+			if !s2.Contains(v) {
+				out = append(out, v)
+			}
+		}
+	}
+
+	for _, v := range s2 {
+		if !s.Contains(v) {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// Empty says if the slice is empty.
+func (s List[T]) Empty() bool {
+	return len(s) == 0
+}
+
+// Equal says if "s" and "s2" are equal.
+func (s List[T]) Equal(s2 List[T]) bool {
+	if len(s) == len(s2) {
+		for k := range s2 {
+			if s2[k] != s[k] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Excludes elements from s2.
+func (s List[T]) Excludes(s2 List[T]) List[T] {
+	out := List[T]{}
+	for _, v := range s {
+		if !s2.Contains(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Filter elements matching the pattern.
+func (s List[T]) Filter(matcher func(v T) bool) List[T] {
+	return s.FindAll(matcher)
+}
+
+// Find the first element matching the pattern.
+func (s List[T]) Find(matcher func(v T) bool) (v T, ok bool) {
+	for _, val := range s {
+		if matcher(val) {
+			return val, true
+		}
+	}
+	return
+}
+
+// FindAll elements matching the pattern.
+func (s List[T]) FindAll(matcher func(v T) bool) List[T] {
+	out := List[T]{}
+	for _, val := range s {
+		if matcher(val) {
+			out = append(out, val)
+		}
+	}
+	return out
+}
+
+// First return the value of the first element.
+func (s List[T]) First() (v T, ok bool) {
+	if len(s) > 0 {
+		return s[0], true
+	}
+	return
+}
+
+// Get the element "i" and say if it has been found.
+func (s List[T]) Get(i int) (v T, ok bool) {
+	if i < 0 || i >= s.Len() {
+		return
+	}
+	return s[i], true
+}
+
+// Intersect return the intersection between "s" and "s2".
+func (s List[T]) Intersect(s2 List[T]) List[T] {
+	out := List[T]{}
+	for _, v := range s {
+		if s2.Contains(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Last return the value of the last element.
+func (s List[T]) Last() (v T, ok bool) {
+	if n := len(s); n > 0 {
+		return s[n-1], true
+	}
+	return
+}
+
+// Len returns the size of the slice.
+func (s List[T]) Len() int {
+	return len(s)
+}
+
+// LenIf return the size of the slice if the filter is valid.
+func (s List[T]) LenIf(f func(v T) bool) (n int) {
+	for _, v := range s {
+		if f(v) {
+			n++
+		}
+	}
+	return
+}
+
+// Take n element and return a new slice.
+func (s List[T]) Take(n int) (out List[T]) {
+	if n < 0 || n > s.Len() {
+		return s
+	}
+	return s[:n].Copy()
+}
+
+// ----------------- CONVERTING METHOD -----------------
+
+// S convert s into []any
+func (s List[T]) S() (out []any) {
+	for _, v := range s {
+		out = append(out, v)
+	}
+	return
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Get(t *testing.T) {
+	s := List[string]{"a", "b"}
+
+	{
+		v, ok := s.Get(1)
+		assert.True(t, ok)
+		assert.Equal(t, "b", v)
+	}
+
+	{
+		v, ok := s.Get(2)
+		assert.False(t, ok)
+		assert.Equal(t, "", v)
+	}
+
+	{
+		v, ok := s.Get(-1)
+		assert.False(t, ok)
+		assert.Equal(t, "", v)
+	}
+}
+
+func TestList_Excludes(t *testing.T) {
+	s := Strings{"1", "2", "3", "4"}
+	assert.Equal(t, Strings{"1", "3"}, s.Excludes(Strings{"2", "4", "5"}))
+	assert.Equal(t, s, s.Excludes(Strings{}))
+}
+
+func TestList_Filter(t *testing.T) {
+	s := Bools{true, false, true}
+	assert.Equal(t, Bools{true, true}, s.Filter(func(v bool) bool { return v }))
+	assert.Equal(t, 1, s.LenIf(func(v bool) bool { return !v }))
+
+	v, ok := s.Find(func(v bool) bool { return !v })
+	assert.True(t, ok)
+	assert.False(t, v)
+}
+
+func TestList_Slice(t *testing.T) {
+	s := Slice{1, "a", nil}
+	assert.True(t, s.Contains("a", nil))
+	assert.False(t, s.Contains(2))
+
+	v, ok := s.Find(func(v any) bool { return v == 3 })
+	assert.False(t, ok)
+	assert.Nil(t, v)
+}
+
+func TestNumberList_SumIf(t *testing.T) {
+	s := Uints{0, 2, 4}
+	assert.Equal(t, uint(6), s.Sum())
+	assert.Equal(t, 2, s.LenIf(func(v uint) bool { return v > 0 }))
+	assert.Equal(t, float64(3), s.MeanIf(func(v uint) bool { return v > 0 }))
+	assert.Equal(t, float64(2), s.Mean())
+}
+
+func TestNumberList_Convert(t *testing.T) {
+	s := Uints{1, 2, 3}
+	assert.Equal(t, Uint64s{1, 2, 3}, s.Uint64s())
+	assert.Equal(t, Floats{1, 2, 3}, s.Floats())
+	assert.Equal(t, List[uint]{1, 2, 3}, s.List())
+}
+
+func TestList_Remove(t *testing.T) {
+	s := Strings{"a", "b", "c", "b"}
+
+	assert.Equal(t, 2, s.Remove("b", "z"))
+	assert.Equal(t, Strings{"a", "c"}, s)
+
+	{
+		v, ok := s.RemoveAt(1)
+		assert.True(t, ok)
+		assert.Equal(t, "c", v)
+	}
+
+	{
+		_, ok := s.RemoveAt(5)
+		assert.False(t, ok)
+	}
+
+	assert.True(t, s.Set(0, "z"))
+	assert.False(t, s.Set(1, "y"))
+	assert.Equal(t, Strings{"z"}, s)
+}
+
+func TestList_PopShift(t *testing.T) {
+	s := Ints{1, 2, 3}
+
+	{
+		v, ok := s.Pop()
+		assert.True(t, ok)
+		assert.Equal(t, 3, v)
+	}
+
+	{
+		v, ok := s.Shift()
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+	}
+
+	assert.Equal(t, Ints{2}, s)
+	s.Pop()
+
+	_, ok := s.Pop()
+	assert.False(t, ok)
+	_, ok = s.Shift()
+	assert.False(t, ok)
+}
@@ -6,6 +6,7 @@
 package types
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -80,6 +81,17 @@ func (m Map) Keys() []string {
 	return out
 }
 
+// SortedKeys return the list of keys in ascending order.
+func (m Map) SortedKeys() []string {
+	return sortedKeys(m)
+}
+
+// Equal says if the map holds the same values as "other", nested maps and
+// slices are compared deeply and numbers are equal when their values are.
+func (m Map) Equal(other map[string]any) bool {
+	return Equal(m, other)
+}
+
 // Len returns the size of the map.
 func (m Map) Len() int {
 	return len(m)
@@ -105,43 +117,328 @@ func (m Map) Get(k string) (v any, ok bool) {
 	return
 }
 
-// String get an element from the map as string.
+// String get an element from the map as string, the zero value is returned
+// when the key doesn't exist or can't be converted.
 func (m Map) String(k string) string {
-	return m[k].(string)
+	v, _ := m.StringE(k)
+	return v
 }
 
+// StringE get an element from the map as string, see ToString.
+func (m Map) StringE(k string) (string, error) {
+	return mapGet(m, k, ToString)
+}
+
+// StringOr get an element from the map as string or "def".
+func (m Map) StringOr(k string, def string) string {
+	if v, err := m.StringE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// StringPtr get an element from the map as *string, nil is returned when the
+// key doesn't exist or can't be converted.
 func (m Map) StringPtr(k string) *string {
-	return StringPtr(m.String(k))
+	if v, err := m.StringE(k); err == nil {
+		return StringPtr(v)
+	}
+	return nil
 }
 
+// Int get an element from the map as int, the zero value is returned
+// when the key doesn't exist or can't be converted.
 func (m Map) Int(k string) int {
-	return m[k].(int)
+	v, _ := m.IntE(k)
+	return v
 }
 
+// IntE get an element from the map as int, see ToInt.
+func (m Map) IntE(k string) (int, error) {
+	return mapGet(m, k, ToInt)
+}
+
+// IntOr get an element from the map as int or "def".
+func (m Map) IntOr(k string, def int) int {
+	if v, err := m.IntE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// IntPtr get an element from the map as *int, nil is returned when the
+// key doesn't exist or can't be converted.
 func (m Map) IntPtr(k string) *int {
-	return IntPtr(m.Int(k))
+	if v, err := m.IntE(k); err == nil {
+		return IntPtr(v)
+	}
+	return nil
 }
 
+// Int64 get an element from the map as int64, the zero value is returned
+// when the key doesn't exist or can't be converted.
 func (m Map) Int64(k string) int64 {
-	return m[k].(int64)
+	v, _ := m.Int64E(k)
+	return v
+}
+
+// Int64E get an element from the map as int64, see ToInt64.
+func (m Map) Int64E(k string) (int64, error) {
+	return mapGet(m, k, ToInt64)
+}
+
+// Int64Or get an element from the map as int64 or "def".
+func (m Map) Int64Or(k string, def int64) int64 {
+	if v, err := m.Int64E(k); err == nil {
+		return v
+	}
+	return def
 }
 
+// Int64Ptr get an element from the map as *int64, nil is returned when the
+// key doesn't exist or can't be converted.
 func (m Map) Int64Ptr(k string) *int64 {
-	return Int64Ptr(m.Int64(k))
+	if v, err := m.Int64E(k); err == nil {
+		return Int64Ptr(v)
+	}
+	return nil
 }
 
+// Uint get an element from the map as uint, the zero value is returned
+// when the key doesn't exist or can't be converted.
+func (m Map) Uint(k string) uint {
+	v, _ := m.UintE(k)
+	return v
+}
+
+// UintE get an element from the map as uint, see ToUint.
+func (m Map) UintE(k string) (uint, error) {
+	return mapGet(m, k, ToUint)
+}
+
+// UintOr get an element from the map as uint or "def".
+func (m Map) UintOr(k string, def uint) uint {
+	if v, err := m.UintE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// UintPtr get an element from the map as *uint, nil is returned when the
+// key doesn't exist or can't be converted.
+func (m Map) UintPtr(k string) *uint {
+	if v, err := m.UintE(k); err == nil {
+		return UintPtr(v)
+	}
+	return nil
+}
+
+// Uint64 get an element from the map as uint64, the zero value is returned
+// when the key doesn't exist or can't be converted.
 func (m Map) Uint64(k string) uint64 {
-	return uint64(m.Int64(k))
+	v, _ := m.Uint64E(k)
+	return v
+}
+
+// Uint64E get an element from the map as uint64, see ToUint64.
+func (m Map) Uint64E(k string) (uint64, error) {
+	return mapGet(m, k, ToUint64)
+}
+
+// Uint64Or get an element from the map as uint64 or "def".
+func (m Map) Uint64Or(k string, def uint64) uint64 {
+	if v, err := m.Uint64E(k); err == nil {
+		return v
+	}
+	return def
 }
 
+// Uint64Ptr get an element from the map as *uint64, nil is returned when the
+// key doesn't exist or can't be converted.
 func (m Map) Uint64Ptr(k string) *uint64 {
-	return Uint64Ptr(m.Uint64(k))
+	if v, err := m.Uint64E(k); err == nil {
+		return Uint64Ptr(v)
+	}
+	return nil
+}
+
+// Float64 get an element from the map as float64, the zero value is returned
+// when the key doesn't exist or can't be converted.
+func (m Map) Float64(k string) float64 {
+	v, _ := m.Float64E(k)
+	return v
+}
+
+// Float64E get an element from the map as float64, see ToFloat64.
+func (m Map) Float64E(k string) (float64, error) {
+	return mapGet(m, k, ToFloat64)
+}
+
+// Float64Or get an element from the map as float64 or "def".
+func (m Map) Float64Or(k string, def float64) float64 {
+	if v, err := m.Float64E(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// Float64Ptr get an element from the map as *float64, nil is returned when the
+// key doesn't exist or can't be converted.
+func (m Map) Float64Ptr(k string) *float64 {
+	if v, err := m.Float64E(k); err == nil {
+		return Float64Ptr(v)
+	}
+	return nil
 }
 
+// Bool get an element from the map as bool, the zero value is returned
+// when the key doesn't exist or can't be converted.
+func (m Map) Bool(k string) bool {
+	v, _ := m.BoolE(k)
+	return v
+}
+
+// BoolE get an element from the map as bool, see ToBool.
+func (m Map) BoolE(k string) (bool, error) {
+	return mapGet(m, k, ToBool)
+}
+
+// BoolOr get an element from the map as bool or "def".
+func (m Map) BoolOr(k string, def bool) bool {
+	if v, err := m.BoolE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// BoolPtr get an element from the map as *bool, nil is returned when the
+// key doesn't exist or can't be converted.
+func (m Map) BoolPtr(k string) *bool {
+	if v, err := m.BoolE(k); err == nil {
+		return BoolPtr(v)
+	}
+	return nil
+}
+
+// Time get an element from the map as time.Time, the zero value is returned
+// when the key doesn't exist or can't be converted.
 func (m Map) Time(k string) time.Time {
-	return m[k].(time.Time)
+	v, _ := m.TimeE(k)
+	return v
+}
+
+// TimeE get an element from the map as time.Time, see ToTime.
+func (m Map) TimeE(k string) (time.Time, error) {
+	return mapGet(m, k, ToTime)
+}
+
+// TimeOr get an element from the map as time.Time or "def".
+func (m Map) TimeOr(k string, def time.Time) time.Time {
+	if v, err := m.TimeE(k); err == nil {
+		return v
+	}
+	return def
 }
 
+// TimePtr get an element from the map as *time.Time, nil is returned when the
+// key doesn't exist or can't be converted.
 func (m Map) TimePtr(k string) *time.Time {
-	return TimePtr(m.Time(k))
+	if v, err := m.TimeE(k); err == nil {
+		return TimePtr(v)
+	}
+	return nil
+}
+
+// Date get an element from the map as Date, the zero value is returned
+// when the key doesn't exist or can't be converted.
+func (m Map) Date(k string) Date {
+	v, _ := m.DateE(k)
+	return v
+}
+
+// DateE get an element from the map as Date, see ToDate.
+func (m Map) DateE(k string) (Date, error) {
+	return mapGet(m, k, ToDate)
+}
+
+// DateOr get an element from the map as Date or "def".
+func (m Map) DateOr(k string, def Date) Date {
+	if v, err := m.DateE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// DateTime get an element from the map as DateTime, the zero value is returned
+// when the key doesn't exist or can't be converted.
+func (m Map) DateTime(k string) DateTime {
+	v, _ := m.DateTimeE(k)
+	return v
+}
+
+// DateTimeE get an element from the map as DateTime, see ToDateTime.
+func (m Map) DateTimeE(k string) (DateTime, error) {
+	return mapGet(m, k, ToDateTime)
+}
+
+// DateTimeOr get an element from the map as DateTime or "def".
+func (m Map) DateTimeOr(k string, def DateTime) DateTime {
+	if v, err := m.DateTimeE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// Strings get an element from the map as Strings, the zero value is returned
+// when the key doesn't exist or can't be converted.
+func (m Map) Strings(k string) Strings {
+	v, _ := m.StringsE(k)
+	return v
+}
+
+// StringsE get an element from the map as Strings, see ToStrings.
+func (m Map) StringsE(k string) (Strings, error) {
+	return mapGet(m, k, ToStrings)
+}
+
+// StringsOr get an element from the map as Strings or "def".
+func (m Map) StringsOr(k string, def Strings) Strings {
+	if v, err := m.StringsE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+// Map get an element from the map as Map, the zero value is returned
+// when the key doesn't exist or can't be converted.
+func (m Map) Map(k string) Map {
+	v, _ := m.MapE(k)
+	return v
+}
+
+// MapE get an element from the map as Map, see ToMap.
+func (m Map) MapE(k string) (Map, error) {
+	return mapGet(m, k, ToMap)
+}
+
+// MapOr get an element from the map as Map or "def".
+func (m Map) MapOr(k string, def Map) Map {
+	if v, err := m.MapE(k); err == nil {
+		return v
+	}
+	return def
+}
+
+func mapGet[T any](m Map, k string, convert func(any) (T, error)) (T, error) {
+	v, ok := m[k]
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("%w: %q", ErrKeyNotFound, k)
+	}
+
+	out, err := convert(v)
+	if err != nil {
+		return out, fmt.Errorf("types: key %q: %w", k, err)
+	}
+	return out, nil
 }
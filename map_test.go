@@ -1 +1,86 @@
 package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap_Int(t *testing.T) {
+	m := Map{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"a":12,"b":"42","c":1.5,"d":-1,"e":"x"}`), &m))
+
+	assert.Equal(t, 12, m.Int("a"))
+	assert.Equal(t, 42, m.Int("b"))
+	assert.Equal(t, int64(42), m.Int64("b"))
+	assert.Equal(t, 0, m.Int("c"))
+	assert.Equal(t, 7, m.IntOr("e", 7))
+	assert.Equal(t, 7, m.IntOr("missing", 7))
+	assert.Nil(t, m.IntPtr("missing"))
+	assert.Equal(t, 12, *m.IntPtr("a"))
+
+	_, err := m.IntE("missing")
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+
+	_, err = m.Uint64E("d")
+	assert.True(t, errors.Is(err, ErrOverflow))
+
+	_, err = m.IntE("e")
+	cerr := &ConvertError{}
+	assert.True(t, errors.As(err, &cerr))
+	assert.Equal(t, "int", cerr.Type)
+}
+
+func TestMap_Uint64(t *testing.T) {
+	m := Map{
+		"u":   uint64(math.MaxUint64),
+		"num": json.Number("18446744073709551615"),
+		"big": json.Number("18446744073709551616"),
+	}
+
+	assert.Equal(t, uint64(math.MaxUint64), m.Uint64("u"))
+	assert.Equal(t, uint64(math.MaxUint64), m.Uint64("num"))
+
+	_, err := m.Int64E("u")
+	assert.True(t, errors.Is(err, ErrOverflow))
+	_, err = m.Uint64E("big")
+	assert.True(t, errors.Is(err, ErrOverflow))
+}
+
+func TestMap_Scalars(t *testing.T) {
+	m := Map{
+		"s":     "hello",
+		"f":     float32(1.5),
+		"b":     "true",
+		"n":     1,
+		"t":     "2020-05-17T13:04:05Z",
+		"d":     "2020-05-17",
+		"dt":    "2020-05-17 13:04:05",
+		"list":  []any{"a", 1, true},
+		"child": map[string]any{"k": "v"},
+	}
+
+	assert.Equal(t, "hello", m.String("s"))
+	assert.Equal(t, "1", m.String("n"))
+	assert.Equal(t, 1.5, m.Float64("f"))
+	assert.True(t, m.Bool("b"))
+	assert.True(t, m.Bool("n"))
+	assert.False(t, m.BoolOr("s", false))
+	assert.Equal(t, time.Date(2020, 5, 17, 13, 4, 5, 0, time.UTC), m.Time("t"))
+	assert.Equal(t, 17, m.Date("d").Day())
+	assert.Equal(t, 13, m.DateTime("dt").Hour())
+	assert.Equal(t, Strings{"a", "1", "true"}, m.Strings("list"))
+	assert.Equal(t, "v", m.Map("child").String("k"))
+	assert.Nil(t, m.Map("s"))
+	assert.Nil(t, m.TimePtr("s"))
+
+	// Nil pointers implementing fmt.Stringer don't panic.
+	m = Map{"t": (*time.Time)(nil), "d": (*Date)(nil)}
+	_, err := m.StringE("t")
+	assert.ErrorAs(t, err, new(*ConvertError))
+	assert.Equal(t, "x", m.StringOr("d", "x"))
+}
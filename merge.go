@@ -0,0 +1,156 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"reflect"
+	"sort"
+)
+
+// MergeStrategy resolves a conflict on "path" between the existing value
+// "old" and the incoming value "new", it returns the value to keep.
+type MergeStrategy func(path string, old, new any) any
+
+var (
+	// MergeKeep keeps the existing value.
+	MergeKeep MergeStrategy = func(_ string, old, _ any) any {
+		return old
+	}
+
+	// MergeOverwrite replaces the existing value with the incoming one.
+	MergeOverwrite MergeStrategy = func(_ string, _, new any) any {
+		return new
+	}
+
+	// MergeAppend appends the incoming slice to the existing one,
+	// other values are overwritten.
+	MergeAppend MergeStrategy = func(_ string, old, new any) any {
+		ov, nv := reflect.ValueOf(old), reflect.ValueOf(new)
+		if ov.Kind() != reflect.Slice || nv.Kind() != reflect.Slice {
+			return new
+		}
+		if ov.Type() == nv.Type() {
+			return reflect.AppendSlice(reflect.AppendSlice(reflect.MakeSlice(ov.Type(), 0, ov.Len()+nv.Len()), ov), nv).Interface()
+		}
+
+		out := make([]any, 0, ov.Len()+nv.Len())
+		for _, v := range []reflect.Value{ov, nv} {
+			for i := 0; i < v.Len(); i++ {
+				out = append(out, v.Index(i).Interface())
+			}
+		}
+		return out
+	}
+
+	// MergeUnion merges two lists of strings without duplicates,
+	// other values are overwritten.
+	MergeUnion MergeStrategy = func(_ string, old, new any) any {
+		os, ook := asStrings(old)
+		ns, nok := asStrings(new)
+		if !ook || !nok {
+			return new
+		}
+
+		out := os.Copy()
+		for _, v := range ns {
+			if !out.Contains(v) {
+				out = append(out, v)
+			}
+		}
+		return out
+	}
+)
+
+// MergeDeep merges "sub" into the map, recursing into nested maps. Conflicting
+// values are resolved with "strategy" and their dotted paths are returned.
+func (m Map) MergeDeep(sub map[string]any, strategy MergeStrategy) (conflicts []string) {
+	if strategy == nil {
+		strategy = MergeOverwrite
+	}
+
+	conflicts = mergeDeep(m, sub, "", strategy)
+	sort.Strings(conflicts)
+	return
+}
+
+func mergeDeep(dst, src map[string]any, prefix string, strategy MergeStrategy) (conflicts []string) {
+	for k, v := range src {
+		path := prefix + k
+		old, ok := dst[k]
+		if !ok {
+			dst[k] = copyValue(v)
+			continue
+		}
+
+		om, oerr := ToMap(old)
+		nm, nerr := ToMap(v)
+		if oerr == nil && nerr == nil && om != nil {
+			conflicts = append(conflicts, mergeDeep(om, nm, path+".", strategy)...)
+			continue
+		}
+
+		if !reflect.DeepEqual(old, v) {
+			conflicts = append(conflicts, path)
+			dst[k] = strategy(path, old, copyValue(v))
+		}
+	}
+	return
+}
+
+// copyValue copies nested maps and slices of any, so that merging into the
+// result never changes the source.
+func copyValue(v any) any {
+	switch t := v.(type) {
+	case Map:
+		return Map(copyMap(t))
+	case map[string]any:
+		return copyMap(t)
+	case Slice:
+		return Slice(copySlice(t))
+	case []any:
+		return copySlice(t)
+	}
+	return v
+}
+
+func copyMap(m map[string]any) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = copyValue(v)
+	}
+	return out
+}
+
+func copySlice(s []any) []any {
+	out := make([]any, len(s))
+	for i, v := range s {
+		out[i] = copyValue(v)
+	}
+	return out
+}
+
+// asStrings says if "v" is a list holding only strings.
+func asStrings(v any) (Strings, bool) {
+	switch t := v.(type) {
+	case Strings:
+		return t, true
+	case []string:
+		return t, true
+	case Slice:
+		return asStrings([]any(t))
+	case []any:
+		out := make(Strings, len(t))
+		for i, e := range t {
+			s, ok := e.(string)
+			if !ok {
+				return nil, false
+			}
+			out[i] = s
+		}
+		return out, true
+	}
+	return nil, false
+}
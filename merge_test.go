@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeMergeLayers() (Map, Map) {
+	defaults := Map{
+		"db":   Map{"host": "localhost", "port": 5432, "pool": map[string]any{"size": 5}},
+		"tags": Strings{"a", "b"},
+		"ids":  []any{1, 2},
+	}
+	file := Map{
+		"db":   map[string]any{"host": "db.local", "pool": Map{"size": 10, "idle": 2}},
+		"tags": []any{"b", "c"},
+		"ids":  []any{3},
+		"name": "app",
+	}
+	return defaults, file
+}
+
+func TestMap_MergeDeep(t *testing.T) {
+	m, file := makeMergeLayers()
+
+	conflicts := m.MergeDeep(file, MergeOverwrite)
+	assert.Equal(t, []string{"db.host", "db.pool.size", "ids", "tags"}, conflicts)
+
+	v, _ := m.Path("db.host")
+	assert.Equal(t, "db.local", v)
+	v, _ = m.Path("db.port")
+	assert.Equal(t, 5432, v)
+	v, _ = m.Path("db.pool.idle")
+	assert.Equal(t, 2, v)
+	assert.Equal(t, "app", m.String("name"))
+
+	// The source layer is never shared with the result.
+	assert.NoError(t, m.SetPath("db.pool.idle", 4))
+	assert.Equal(t, 2, file.Map("db").Map("pool").Int("idle"))
+}
+
+func TestMap_MergeDeepStrategies(t *testing.T) {
+	{
+		m, file := makeMergeLayers()
+		m.MergeDeep(file, MergeKeep)
+		v, _ := m.Path("db.host")
+		assert.Equal(t, "localhost", v)
+		assert.Equal(t, "app", m.String("name"))
+	}
+
+	{
+		m, file := makeMergeLayers()
+		m.MergeDeep(file, MergeAppend)
+		assert.Equal(t, []any{1, 2, 3}, m["ids"])
+		assert.Equal(t, []any{"a", "b", "b", "c"}, m["tags"])
+	}
+
+	{
+		m, file := makeMergeLayers()
+		m.MergeDeep(file, MergeUnion)
+		assert.Equal(t, Strings{"a", "b", "c"}, m["tags"])
+		assert.Equal(t, []any{3}, m["ids"])
+	}
+
+	{
+		m, file := makeMergeLayers()
+		paths := Strings{}
+		m.MergeDeep(file, func(path string, old, new any) any {
+			paths.Add(path)
+			return old
+		})
+		assert.ElementsMatch(t, Strings{"db.host", "db.pool.size", "ids", "tags"}, paths)
+	}
+}
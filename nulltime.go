@@ -0,0 +1,397 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+var nullJSON = []byte("null")
+
+// jsonText returns the content of the JSON string "b", so that the string
+// "null" is parsed as a value rather than taken for the null token.
+func jsonText(b []byte) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// NewNullDate returns a new valid NullDate from a time.Time.
+func NewNullDate(t time.Time) NullDate {
+	return NullDate{NewDate(t), true}
+}
+
+// NullDate is a Date that may be null.
+type NullDate struct {
+	Date
+	Valid bool
+}
+
+// IsNull says if the date is null.
+func (d NullDate) IsNull() bool {
+	return !d.Valid
+}
+
+// Ptr returns a pointer to the date, nil when the date is null.
+func (d NullDate) Ptr() *Date {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Date
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *NullDate) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, nullJSON) {
+		*d = NullDate{}
+		return nil
+	}
+
+	s, err := jsonText(b)
+	if err != nil {
+		return err
+	}
+	if err := d.Date.UnmarshalText(s); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d NullDate) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return nullJSON, nil
+	}
+	return d.Date.MarshalJSON()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d NullDate) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.Date.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *NullDate) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*d = NullDate{}
+		return nil
+	}
+
+	if err := d.Date.UnmarshalText(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// String returns the string representation of the date, empty when null.
+func (d NullDate) String() string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Date.String()
+}
+
+// Value implements the database.Valuer interface.
+func (d NullDate) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Date.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *NullDate) Scan(src any) error {
+	if src == nil {
+		*d = NullDate{}
+		return nil
+	}
+
+	if err := d.Date.Scan(src); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface, a null date is empty.
+func (d NullDate) GobEncode() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.Date.GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (d *NullDate) GobDecode(b []byte) error {
+	if len(b) == 0 {
+		*d = NullDate{}
+		return nil
+	}
+
+	if err := d.Date.GobDecode(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// NewNullDateYearMonth returns a new valid NullDateYearMonth from a time.Time.
+func NewNullDateYearMonth(t time.Time) NullDateYearMonth {
+	return NullDateYearMonth{NewDateYearMonth(t), true}
+}
+
+// NullDateYearMonth is a DateYearMonth that may be null.
+type NullDateYearMonth struct {
+	DateYearMonth
+	Valid bool
+}
+
+// IsNull says if the date is null.
+func (d NullDateYearMonth) IsNull() bool {
+	return !d.Valid
+}
+
+// Ptr returns a pointer to the date, nil when the date is null.
+func (d NullDateYearMonth) Ptr() *DateYearMonth {
+	if !d.Valid {
+		return nil
+	}
+	return &d.DateYearMonth
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *NullDateYearMonth) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, nullJSON) {
+		*d = NullDateYearMonth{}
+		return nil
+	}
+
+	s, err := jsonText(b)
+	if err != nil {
+		return err
+	}
+	if err := d.DateYearMonth.UnmarshalText(s); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d NullDateYearMonth) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return nullJSON, nil
+	}
+	return d.DateYearMonth.MarshalJSON()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d NullDateYearMonth) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.DateYearMonth.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *NullDateYearMonth) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*d = NullDateYearMonth{}
+		return nil
+	}
+
+	if err := d.DateYearMonth.UnmarshalText(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// String returns the string representation of the date, empty when null.
+func (d NullDateYearMonth) String() string {
+	if !d.Valid {
+		return ""
+	}
+	return d.DateYearMonth.String()
+}
+
+// Value implements the database.Valuer interface.
+func (d NullDateYearMonth) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.DateYearMonth.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *NullDateYearMonth) Scan(src any) error {
+	if src == nil {
+		*d = NullDateYearMonth{}
+		return nil
+	}
+
+	if err := d.DateYearMonth.Scan(src); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface, a null date is empty.
+func (d NullDateYearMonth) GobEncode() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.DateYearMonth.GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (d *NullDateYearMonth) GobDecode(b []byte) error {
+	if len(b) == 0 {
+		*d = NullDateYearMonth{}
+		return nil
+	}
+
+	if err := d.DateYearMonth.GobDecode(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// NewNullDateTime returns a new valid NullDateTime from a time.Time.
+func NewNullDateTime(t time.Time) NullDateTime {
+	return NullDateTime{NewDateTime(t), true}
+}
+
+// NullDateTime is a DateTime that may be null.
+type NullDateTime struct {
+	DateTime
+	Valid bool
+}
+
+// IsNull says if the datetime is null.
+func (d NullDateTime) IsNull() bool {
+	return !d.Valid
+}
+
+// Ptr returns a pointer to the datetime, nil when the datetime is null.
+func (d NullDateTime) Ptr() *DateTime {
+	if !d.Valid {
+		return nil
+	}
+	return &d.DateTime
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *NullDateTime) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, nullJSON) {
+		*d = NullDateTime{}
+		return nil
+	}
+
+	s, err := jsonText(b)
+	if err != nil {
+		return err
+	}
+	if err := d.DateTime.UnmarshalText(s); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d NullDateTime) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return nullJSON, nil
+	}
+	return d.DateTime.MarshalJSON()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d NullDateTime) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.DateTime.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *NullDateTime) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*d = NullDateTime{}
+		return nil
+	}
+
+	if err := d.DateTime.UnmarshalText(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// String returns the string representation of the datetime, empty when null.
+func (d NullDateTime) String() string {
+	if !d.Valid {
+		return ""
+	}
+	return d.DateTime.String()
+}
+
+// Value implements the database.Valuer interface.
+func (d NullDateTime) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.DateTime.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *NullDateTime) Scan(src any) error {
+	if src == nil {
+		*d = NullDateTime{}
+		return nil
+	}
+
+	if err := d.DateTime.Scan(src); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface, a null datetime is empty.
+func (d NullDateTime) GobEncode() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return d.DateTime.GobEncode()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (d *NullDateTime) GobDecode(b []byte) error {
+	if len(b) == 0 {
+		*d = NullDateTime{}
+		return nil
+	}
+
+	if err := d.DateTime.GobDecode(b); err != nil {
+		return err
+	}
+	d.Valid = true
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullDate_JSON(t *testing.T) {
+	type payload struct {
+		Day   NullDate          `json:"day"`
+		Month NullDateYearMonth `json:"month"`
+		At    NullDateTime      `json:"at"`
+	}
+
+	out := payload{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"day":null,"month":"2020-05","at":"2020-05-17 13:04:05"}`), &out))
+	assert.True(t, out.Day.IsNull())
+	assert.False(t, out.Month.IsNull())
+	assert.Equal(t, time.Date(2020, 5, 17, 13, 4, 5, 0, time.UTC), out.At.Time)
+
+	b, err := json.Marshal(out)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"day":null,"month":"2020-05","at":"2020-05-17 13:04:05"}`, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"day":"17/05/2020"}`), &out))
+
+	// Only the null token clears the value, the string "null" is invalid.
+	for _, in := range []string{`{"day":"null"}`, `{"month":"null"}`, `{"at":"null"}`} {
+		out = payload{}
+		assert.Error(t, json.Unmarshal([]byte(in), &out), in)
+		assert.False(t, out.Day.Valid || out.Month.Valid || out.At.Valid, in)
+	}
+}
+
+func TestNullDate_Text(t *testing.T) {
+	d := NullDate{}
+	assert.NoError(t, d.UnmarshalText([]byte("2020-05-17")))
+	assert.True(t, d.Valid)
+
+	assert.NoError(t, d.UnmarshalText([]byte{}))
+	assert.True(t, d.IsNull())
+	assert.Nil(t, d.Ptr())
+
+	b, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Empty(t, b)
+	assert.Equal(t, "", d.String())
+}
+
+func TestNullDate_SQL(t *testing.T) {
+	d := NullDate{}
+	v, err := d.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	d = NewNullDate(time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC))
+	v, err = d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-05-17", v)
+
+	assert.NoError(t, fakeScan(t, nil, &d))
+	assert.True(t, d.IsNull())
+
+	assert.NoError(t, fakeScan(t, "2020-05-17", &d))
+	assert.False(t, d.IsNull())
+	assert.Equal(t, 17, d.Day())
+
+	dt := NullDateTime{}
+	assert.NoError(t, fakeScan(t, "2020-05-17 13:04:05", &dt))
+	assert.True(t, dt.Valid)
+	assert.Error(t, fakeScan(t, 12.5, &dt))
+}
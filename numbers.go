@@ -0,0 +1,209 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// Number is the constraint satisfied by every integer and float type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// NumberList is a List of numbers, with the aggregation methods on top.
+type NumberList[T Number] []T
+
+// Reset the slice.
+func (s *NumberList[T]) Reset() {
+	(*List[T])(s).Reset()
+}
+
+// Add new elements to the slice.
+func (s *NumberList[T]) Add(values ...T) {
+	(*List[T])(s).Add(values...)
+}
+
+// Set the element "i" and say if it exists.
+func (s NumberList[T]) Set(i int, v T) bool {
+	return List[T](s).Set(i, v)
+}
+
+// RemoveAt remove the element "i" and return it.
+func (s *NumberList[T]) RemoveAt(i int) (T, bool) {
+	return (*List[T])(s).RemoveAt(i)
+}
+
+// Remove every occurrence of "values" and return the number of removed elements.
+func (s *NumberList[T]) Remove(values ...T) int {
+	return (*List[T])(s).Remove(values...)
+}
+
+// Pop remove the last element and return it.
+func (s *NumberList[T]) Pop() (T, bool) {
+	return (*List[T])(s).Pop()
+}
+
+// Shift remove the first element and return it.
+func (s *NumberList[T]) Shift() (T, bool) {
+	return (*List[T])(s).Shift()
+}
+
+// Contains say if "s" contains "values".
+func (s NumberList[T]) Contains(values ...T) bool {
+	return List[T](s).Contains(values...)
+}
+
+// ContainsOneOf says if "s" contains one of the "values".
+func (s NumberList[T]) ContainsOneOf(values ...T) bool {
+	return List[T](s).ContainsOneOf(values...)
+}
+
+// Copy create a new copy of the slice.
+func (s NumberList[T]) Copy() NumberList[T] {
+	return NumberList[T](List[T](s).Copy())
+}
+
+// Diff returns the difference between "s" and "s2".
+func (s NumberList[T]) Diff(s2 NumberList[T]) NumberList[T] {
+	return NumberList[T](List[T](s).Diff(List[T](s2)))
+}
+
+// Empty says if the slice is empty.
+func (s NumberList[T]) Empty() bool {
+	return len(s) == 0
+}
+
+// Equal says if "s" and "s2" are equal.
+func (s NumberList[T]) Equal(s2 NumberList[T]) bool {
+	return List[T](s).Equal(List[T](s2))
+}
+
+// Excludes elements from s2.
+func (s NumberList[T]) Excludes(s2 NumberList[T]) NumberList[T] {
+	return NumberList[T](List[T](s).Excludes(List[T](s2)))
+}
+
+// Filter elements matching the pattern.
+func (s NumberList[T]) Filter(matcher func(v T) bool) NumberList[T] {
+	return NumberList[T](List[T](s).Filter(matcher))
+}
+
+// Find the first element matching the pattern.
+func (s NumberList[T]) Find(matcher func(v T) bool) (T, bool) {
+	return List[T](s).Find(matcher)
+}
+
+// FindAll elements matching the pattern.
+func (s NumberList[T]) FindAll(matcher func(v T) bool) NumberList[T] {
+	return NumberList[T](List[T](s).FindAll(matcher))
+}
+
+// First return the value of the first element.
+func (s NumberList[T]) First() (T, bool) {
+	return List[T](s).First()
+}
+
+// Get the element "i" and say if it has been found.
+func (s NumberList[T]) Get(i int) (T, bool) {
+	return List[T](s).Get(i)
+}
+
+// Intersect return the intersection between "s" and "s2".
+func (s NumberList[T]) Intersect(s2 NumberList[T]) NumberList[T] {
+	return NumberList[T](List[T](s).Intersect(List[T](s2)))
+}
+
+// Last return the value of the last element.
+func (s NumberList[T]) Last() (T, bool) {
+	return List[T](s).Last()
+}
+
+// Len returns the size of the slice.
+func (s NumberList[T]) Len() int {
+	return len(s)
+}
+
+// LenIf return the size of the slice if the filter is valid.
+func (s NumberList[T]) LenIf(f func(v T) bool) int {
+	return List[T](s).LenIf(f)
+}
+
+// Mean of the slice.
+func (s NumberList[T]) Mean() (mean float64) {
+	return float64(s.Sum()) / float64(s.Len())
+}
+
+// MeanIf the filter is valid of the slice.
+func (s NumberList[T]) MeanIf(f func(v T) bool) (mean float64) {
+	return float64(s.SumIf(f)) / float64(s.LenIf(f))
+}
+
+// Sum of the slice.
+func (s NumberList[T]) Sum() (sum T) {
+	for _, v := range s {
+		sum += v
+	}
+	return
+}
+
+// SumIf the filter is valid of the slice.
+func (s NumberList[T]) SumIf(f func(v T) bool) (sum T) {
+	for _, v := range s {
+		if f(v) {
+			sum += v
+		}
+	}
+	return
+}
+
+// Take n element and return a new slice.
+func (s NumberList[T]) Take(n int) NumberList[T] {
+	return NumberList[T](List[T](s).Take(n))
+}
+
+// ----------------- CONVERTING METHOD -----------------
+
+// List convert s into a List.
+func (s NumberList[T]) List() List[T] {
+	return List[T](s)
+}
+
+// S convert s into []any
+func (s NumberList[T]) S() []any {
+	return List[T](s).S()
+}
+
+// Ints convert s into Ints
+func (s NumberList[T]) Ints() Ints {
+	return convertNumbers[T, int](s)
+}
+
+// Int64s convert s into Int64s
+func (s NumberList[T]) Int64s() Int64s {
+	return convertNumbers[T, int64](s)
+}
+
+// Uints convert s into Uints
+func (s NumberList[T]) Uints() Uints {
+	return convertNumbers[T, uint](s)
+}
+
+// Uint64s convert s into Uint64s
+func (s NumberList[T]) Uint64s() Uint64s {
+	return convertNumbers[T, uint64](s)
+}
+
+// Floats convert s into Floats
+func (s NumberList[T]) Floats() Floats {
+	return convertNumbers[T, float64](s)
+}
+
+func convertNumbers[T, U Number](s NumberList[T]) NumberList[U] {
+	out := make(NumberList[U], len(s))
+	for i, v := range s {
+		out[i] = U(v)
+	}
+	return out
+}
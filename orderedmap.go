@@ -0,0 +1,342 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// OrderedMap is a hashmap keeping the insertion order of its keys.
+// The zero value is an empty map ready to use.
+type OrderedMap struct {
+	entries map[string]*list.Element
+	order   list.List
+}
+
+type orderedEntry struct {
+	key   string
+	value any
+}
+
+// NewOrderedMap returns a new OrderedMap.
+func NewOrderedMap() *OrderedMap {
+	return &OrderedMap{}
+}
+
+// OrderedMapFrom returns a new OrderedMap holding the keys of "m" in sorted order.
+func OrderedMapFrom(m map[string]any) *OrderedMap {
+	out := NewOrderedMap()
+	out.Merge(m)
+	return out
+}
+
+func (m *OrderedMap) init() {
+	if m.entries == nil {
+		m.entries = map[string]*list.Element{}
+	}
+}
+
+// Reset the values of the map.
+func (m *OrderedMap) Reset() {
+	m.entries = map[string]*list.Element{}
+	m.order.Init()
+}
+
+// Add a value to the map if the key doesn't exists.
+func (m *OrderedMap) Add(k string, v any) {
+	if _, ok := m.entries[k]; !ok {
+		m.Set(k, v)
+	}
+}
+
+// Set a new value in the map, a new key is placed at the back.
+func (m *OrderedMap) Set(k string, v any) {
+	m.init()
+	if e, ok := m.entries[k]; ok {
+		e.Value.(*orderedEntry).value = v
+		return
+	}
+	m.entries[k] = m.order.PushBack(&orderedEntry{k, v})
+}
+
+// Get an element from the map.
+func (m *OrderedMap) Get(k string) (any, bool) {
+	if e, ok := m.entries[k]; ok {
+		return e.Value.(*orderedEntry).value, true
+	}
+	return nil, false
+}
+
+// Delete the key "k" and say if it existed.
+func (m *OrderedMap) Delete(k string) bool {
+	e, ok := m.entries[k]
+	if ok {
+		m.order.Remove(e)
+		delete(m.entries, k)
+	}
+	return ok
+}
+
+// Merge another map, the new keys are added in sorted order.
+func (m *OrderedMap) Merge(sub map[string]any) {
+	for _, k := range sortedKeys(sub) {
+		m.Add(k, sub[k])
+	}
+}
+
+// MergeOrdered merges another OrderedMap, the new keys are added in its order.
+func (m *OrderedMap) MergeOrdered(sub *OrderedMap) {
+	sub.Range(func(k string, v any) bool {
+		m.Add(k, v)
+		return true
+	})
+}
+
+// Copy the keys and values into a new map.
+func (m *OrderedMap) Copy() *OrderedMap {
+	out := NewOrderedMap()
+	out.MergeOrdered(m)
+	return out
+}
+
+// Find the first element matching the pattern.
+func (m *OrderedMap) Find(matcher Matcher) (k string, v any, ok bool) {
+	m.Range(func(key string, value any) bool {
+		if matcher(key, value) {
+			k, v, ok = key, value, true
+		}
+		return !ok
+	})
+	return
+}
+
+// FindAll elements matching the pattern.
+func (m *OrderedMap) FindAll(matcher Matcher) *OrderedMap {
+	out := NewOrderedMap()
+	m.Range(func(k string, v any) bool {
+		if matcher(k, v) {
+			out.Set(k, v)
+		}
+		return true
+	})
+	return out
+}
+
+// KeyExists says if the list of keys exists.
+func (m *OrderedMap) KeyExists(keys ...string) bool {
+	for _, k := range keys {
+		if _, ok := m.entries[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys return the list of keys.
+func (m *OrderedMap) Keys() []string {
+	out := make([]string, 0, m.Len())
+	m.Range(func(k string, _ any) bool {
+		out = append(out, k)
+		return true
+	})
+	return out
+}
+
+// Values return the list of values.
+func (m *OrderedMap) Values() []any {
+	out := make([]any, 0, m.Len())
+	m.Range(func(_ string, v any) bool {
+		out = append(out, v)
+		return true
+	})
+	return out
+}
+
+// Len returns the size of the map.
+func (m *OrderedMap) Len() int {
+	return len(m.entries)
+}
+
+// Range calls "f" for each key in order until it returns false.
+func (m *OrderedMap) Range(f func(k string, v any) bool) {
+	for e := m.order.Front(); e != nil; e = e.Next() {
+		entry := e.Value.(*orderedEntry)
+		if !f(entry.key, entry.value) {
+			return
+		}
+	}
+}
+
+// MoveToFront moves the key "k" at the front and say if it exists.
+func (m *OrderedMap) MoveToFront(k string) bool {
+	e, ok := m.entries[k]
+	if ok {
+		m.order.MoveToFront(e)
+	}
+	return ok
+}
+
+// MoveToBack moves the key "k" at the back and say if it exists.
+func (m *OrderedMap) MoveToBack(k string) bool {
+	e, ok := m.entries[k]
+	if ok {
+		m.order.MoveToBack(e)
+	}
+	return ok
+}
+
+// Map converts the OrderedMap into a Map, the order is lost.
+func (m *OrderedMap) Map() Map {
+	out := make(Map, m.Len())
+	m.Range(func(k string, v any) bool {
+		out[k] = v
+		return true
+	})
+	return out
+}
+
+// MarshalJSON implements the json.Marshaler interface, keys are written in order.
+func (m *OrderedMap) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBuffer([]byte{})
+	b.WriteRune('{')
+
+	var err error
+	m.Range(func(k string, v any) bool {
+		if b.Len() > 1 {
+			b.WriteRune(',')
+		}
+
+		var raw []byte
+		if raw, err = json.Marshal(k); err != nil {
+			return false
+		}
+		b.Write(raw)
+		b.WriteRune(':')
+
+		if raw, err = json.Marshal(v); err != nil {
+			return false
+		}
+		b.Write(raw)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.WriteRune('}')
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, keys are added in
+// the order of the document and nested objects are decoded as *OrderedMap.
+// Like encoding/json, null is a no-op.
+func (m *OrderedMap) UnmarshalJSON(b []byte) error {
+	return m.unmarshalJSON(b, func(n json.Number) any {
+		f, _ := n.Float64()
+		return f
+	})
+}
+
+// unmarshalJSON decodes the object "b", numbers are converted by "number".
+func (m *OrderedMap) unmarshalJSON(b []byte, number func(json.Number) any) error {
+	if bytes.Equal(bytes.TrimSpace(b), nullJSON) {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("types: cannot unmarshal %v into OrderedMap", tok)
+	}
+
+	m.Reset()
+	return m.decode(dec, number)
+}
+
+// decode the members of an object whose '{' has been read.
+func (m *OrderedMap) decode(dec *json.Decoder, number func(json.Number) any) error {
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		v, err := decodeOrderedValue(dec, number)
+		if err != nil {
+			return err
+		}
+		m.Set(tok.(string), v)
+	}
+
+	_, err := dec.Token()
+	return err
+}
+
+func decodeOrderedValue(dec *json.Decoder, number func(json.Number) any) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		m := NewOrderedMap()
+		return m, m.decode(dec, number)
+	case json.Delim('['):
+		out := []any{}
+		for dec.More() {
+			v, err := decodeOrderedValue(dec, number)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+		}
+		_, err := dec.Token()
+		return out, err
+	}
+	if n, ok := tok.(json.Number); ok {
+		return number(n), nil
+	}
+	return tok, nil
+}
+
+// orderedGob is the gob encoding of an OrderedMap.
+type orderedGob struct {
+	Keys   []string
+	Values []any
+}
+
+// GobEncode implements the gob.GobEncoder interface, keys are written in order.
+func (m *OrderedMap) GobEncode() ([]byte, error) {
+	b := bytes.NewBuffer([]byte{})
+	err := gob.NewEncoder(b).Encode(orderedGob{m.Keys(), m.Values()})
+	return b.Bytes(), err
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (m *OrderedMap) GobDecode(b []byte) error {
+	var v orderedGob
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&v); err != nil {
+		return err
+	}
+	if len(v.Keys) != len(v.Values) {
+		return fmt.Errorf("types: cannot decode OrderedMap with %d keys and %d values", len(v.Keys), len(v.Values))
+	}
+
+	m.Reset()
+	for i, k := range v.Keys {
+		m.Set(k, v.Values[i])
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap_Order(t *testing.T) {
+	m := OrderedMap{}
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Add("c", 3)
+	m.Add("b", 4)
+	m.Set("a", 5)
+
+	assert.Equal(t, []string{"b", "a", "c"}, m.Keys())
+	assert.Equal(t, []any{1, 5, 3}, m.Values())
+
+	assert.True(t, m.MoveToFront("c"))
+	assert.True(t, m.MoveToBack("b"))
+	assert.False(t, m.MoveToBack("z"))
+	assert.Equal(t, []string{"c", "a", "b"}, m.Keys())
+
+	assert.True(t, m.Delete("a"))
+	assert.False(t, m.Delete("a"))
+	assert.Equal(t, []string{"c", "b"}, m.Keys())
+	assert.Equal(t, Map{"c": 3, "b": 1}, m.Map())
+}
+
+func TestOrderedMap_Find(t *testing.T) {
+	m := OrderedMapFrom(map[string]any{"b": 2, "a": 1, "c": 3})
+	assert.Equal(t, []string{"a", "b", "c"}, m.Keys())
+
+	k, v, ok := m.Find(func(k string, v any) bool { return v.(int) > 1 })
+	assert.True(t, ok)
+	assert.Equal(t, "b", k)
+	assert.Equal(t, 2, v)
+
+	all := m.FindAll(func(k string, v any) bool { return k != "b" })
+	assert.Equal(t, []string{"a", "c"}, all.Keys())
+
+	m2 := m.Copy()
+	m2.Set("d", 4)
+	assert.Equal(t, 3, m.Len())
+	assert.True(t, m2.KeyExists("a", "d"))
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	doc := `{"z":1,"a":{"y":true,"b":null},"m":[{"k2":"v","k1":"w"}],"n":"s"}`
+
+	m := NewOrderedMap()
+	assert.NoError(t, json.Unmarshal([]byte(doc), m))
+	assert.Equal(t, []string{"z", "a", "m", "n"}, m.Keys())
+
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, doc, string(b))
+
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), m))
+
+	var out struct {
+		M OrderedMap `json:"m"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"m":{"a":1}}`), &out))
+	assert.NoError(t, json.Unmarshal([]byte(`{"m":null}`), &out))
+	assert.Equal(t, []string{"a"}, out.M.Keys())
+}
+
+func TestSyncOrderedMap(t *testing.T) {
+	m := SyncOrderedMap()
+	wg := sync.WaitGroup{}
+	for _, k := range []string{"a", "b", "c", "d"} {
+		wg.Add(1)
+		go func(k string) {
+			defer wg.Done()
+			m.Set(k, k)
+		}(k)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 4, m.Len())
+	assert.True(t, m.MoveToFront("d"))
+	assert.Equal(t, "d", m.Keys()[0])
+
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"d":"d"`, string(b[:8]))
+}
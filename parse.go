@@ -0,0 +1,103 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LenientFormats is the list of layouts tried in order by the lenient parsers,
+// before falling back to Unix timestamps.
+var LenientFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	DateTimeFormat,
+	DateFormat,
+	"02/01/2006",
+}
+
+// ParseError is returned when a string doesn't match the expected format.
+type ParseError struct {
+	// Type is the name of the type being parsed.
+	Type string
+
+	// Value is the string that failed to parse.
+	Value string
+
+	// Format is the expected layout.
+	Format string
+
+	// Err is the underlying error from the time package.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("types: cannot parse %q as %s, expected format %q", e.Value, e.Type, e.Format)
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseTime parses "s" in "loc" with the first matching layout.
+func parseTime(name, s string, loc *time.Location, layouts ...string) (time.Time, error) {
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	format := ""
+	if len(layouts) > 0 {
+		format = layouts[0]
+	}
+	return time.Time{}, &ParseError{name, s, format, err}
+}
+
+// ParseTimeLenient parses "s" in "loc" with LenientFormats then as a Unix timestamp in seconds.
+// Timestamps must have 9 or 10 digits (1973 to 2286), so that compact dates
+// like "20200517" aren't taken for timestamps.
+func ParseTimeLenient(s string, loc *time.Location) (time.Time, error) {
+	return parseLenient("time", s, loc)
+}
+
+// ParseDateLenient returns the Date of the given string, see ParseTimeLenient.
+func ParseDateLenient(s string) (Date, error) {
+	t, err := parseLenient("Date", s, time.UTC)
+	return NewDate(t), err
+}
+
+// ParseDateTimeLenient returns the DateTime of the given string, see ParseTimeLenient.
+func ParseDateTimeLenient(s string) (DateTime, error) {
+	d := DateTime{}
+	t, err := parseLenient("DateTime", s, d.wireLocation())
+	if err != nil {
+		return d, err
+	}
+	d.Time = t.In(d.location())
+	return d, nil
+}
+
+func parseLenient(name, s string, loc *time.Location) (time.Time, error) {
+	t, err := parseTime(name, s, loc, LenientFormats...)
+	if err == nil {
+		return t, nil
+	}
+
+	if len(s) >= 9 && len(s) <= 10 {
+		if sec, serr := strconv.ParseUint(s, 10, 64); serr == nil {
+			return time.Unix(int64(sec), 0).In(loc), nil
+		}
+	}
+	return time.Time{}, &ParseError{name, s, strings.Join(LenientFormats, ", ") + " or a Unix timestamp", errors.Unwrap(err)}
+}
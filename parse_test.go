@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDateE(t *testing.T) {
+	d, err := ParseDateE("2020-05-17")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC), d.Time)
+
+	_, err = ParseDateE("17/05/2020")
+	perr := &ParseError{}
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, "Date", perr.Type)
+	assert.Equal(t, DateFormat, perr.Format)
+	assert.Equal(t, "17/05/2020", perr.Value)
+
+	_, err = ParseDateTimeE("2020-05-17")
+	assert.EqualError(t, err, `types: cannot parse "2020-05-17" as DateTime, expected format "2006-01-02 15:04:05"`)
+
+	_, err = ParseDateYearMonthE("2020-13")
+	assert.Error(t, err)
+
+	assert.Panics(t, func() { MustParseDate("") })
+	assert.NotPanics(t, func() { MustParseDateTime("2020-05-17 13:04:05") })
+}
+
+func TestParseDateLenient(t *testing.T) {
+	expected := time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC)
+
+	for _, s := range []string{"2020-05-17T00:00:00Z", "2020-05-17T00:00:00", "2020-05-17", "17/05/2020", "1589673600"} {
+		d, err := ParseDateLenient(s)
+		assert.NoError(t, err, s)
+		assert.True(t, expected.Equal(d.Time), s)
+	}
+
+	for _, s := range []string{"20200517", "2020", "20200517130405", "-1589673600"} {
+		_, err := ParseDateLenient(s)
+		assert.Error(t, err, s)
+	}
+
+	_, err := ParseDateLenient("yesterday")
+	perr := &ParseError{}
+	assert.True(t, errors.As(err, &perr))
+	assert.Equal(t, "Date", perr.Type)
+
+	dt, err := ParseDateTimeLenient("2020-05-17T13:04:05+02:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-05-17 11:04:05", dt.String())
+
+	// An empty list of formats only accepts timestamps.
+	defer func(formats []string) { LenientFormats = formats }(LenientFormats)
+	LenientFormats = nil
+	_, err = ParseTimeLenient("yesterday", time.UTC)
+	assert.True(t, errors.As(err, &perr))
+	_, err = ParseTimeLenient("1589673600", time.UTC)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,315 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrInvalidPatch is returned when a patch operation is malformed.
+	ErrInvalidPatch = errors.New("types: invalid patch operation")
+
+	// ErrTestFailed is returned when a "test" patch operation doesn't match.
+	ErrTestFailed = errors.New("types: test operation failed")
+)
+
+// PatchOp is a RFC 6902 JSON Patch operation.
+type PatchOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, the value is always
+// written for the operations that need one, even when it is null.
+func (op PatchOp) MarshalJSON() ([]byte, error) {
+	out := map[string]any{"op": op.Op, "path": op.Path}
+	switch op.Op {
+	case "add", "replace", "test":
+		out["value"] = op.Value
+	case "move", "copy":
+		out["from"] = op.From
+	}
+	return json.Marshal(out)
+}
+
+// Patch is a RFC 6902 JSON Patch document.
+type Patch []PatchOp
+
+// ApplyMergePatch applies the RFC 7386 JSON Merge Patch "patch" to the map.
+func (m Map) ApplyMergePatch(patch map[string]any) {
+	mergePatch(m, patch)
+}
+
+func mergePatch(dst, patch map[string]any) {
+	for k, v := range patch {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+
+		pm, err := ToMap(v)
+		if err != nil {
+			dst[k] = copyValue(v)
+			continue
+		}
+
+		target, err := ToMap(dst[k])
+		if err != nil || target == nil {
+			target = Map{}
+		}
+		mergePatch(target, pm)
+		dst[k] = target
+	}
+}
+
+// ApplyPatch applies the RFC 6902 JSON Patch "patch" to the map. The
+// operations are applied atomically, the map is left unchanged on error.
+func (m Map) ApplyPatch(patch Patch) error {
+	doc := Map(copyMap(m))
+	for i, op := range patch {
+		if err := doc.applyOp(op); err != nil {
+			return fmt.Errorf("types: patch operation %d (%s %q): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	for k := range m {
+		delete(m, k)
+	}
+	for k, v := range doc {
+		m[k] = v
+	}
+	return nil
+}
+
+func (m Map) applyOp(op PatchOp) error {
+	segs, err := ParsePointer(op.Path)
+	if err != nil {
+		return err
+	}
+
+	switch op.Op {
+	case "add":
+		return m.add(op.Path, segs, copyValue(op.Value))
+	case "remove":
+		return m.delete(op.Path, segs)
+	case "replace":
+		if _, err := m.lookup(op.Path, segs); err != nil {
+			return err
+		}
+		if len(segs) == 0 {
+			return m.add(op.Path, segs, copyValue(op.Value))
+		}
+		return m.set(op.Path, segs, copyValue(op.Value))
+	case "move", "copy":
+		from, err := ParsePointer(op.From)
+		if err != nil {
+			return err
+		}
+		v, err := m.lookup(op.From, from)
+		if err != nil {
+			return err
+		}
+
+		if op.Op == "copy" {
+			return m.add(op.Path, segs, copyValue(v))
+		}
+		if op.From == op.Path {
+			return nil
+		}
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return fmt.Errorf("%w: cannot move %q into itself", ErrInvalidPatch, op.From)
+		}
+		if err := m.delete(op.From, from); err != nil {
+			return err
+		}
+		return m.add(op.Path, segs, v)
+	case "test":
+		v, err := m.lookup(op.Path, segs)
+		if err != nil {
+			return err
+		}
+		if !Equal(v, op.Value) {
+			return ErrTestFailed
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: unknown op %q", ErrInvalidPatch, op.Op)
+}
+
+// add implements the "add" operation, values are inserted in slices.
+func (m Map) add(path string, segs []string, v any) error {
+	if len(segs) == 0 {
+		doc, err := ToMap(v)
+		if err != nil {
+			return &PathError{path, "", ErrNotContainer}
+		}
+		for k := range m {
+			delete(m, k)
+		}
+		for k, e := range doc {
+			m[k] = e
+		}
+		return nil
+	}
+
+	parentSegs, last := segs[:len(segs)-1], segs[len(segs)-1]
+	parent, err := m.lookup(path, parentSegs)
+	if err != nil {
+		return err
+	}
+
+	switch p := parent.(type) {
+	case Map:
+		p[last] = v
+		return nil
+	case map[string]any:
+		p[last] = v
+		return nil
+	case Slice:
+		s, err := insertAt(p, path, last, v)
+		if err != nil {
+			return err
+		}
+		return m.set(path, parentSegs, Slice(s))
+	case []any:
+		s, err := insertAt(p, path, last, v)
+		if err != nil {
+			return err
+		}
+		return m.set(path, parentSegs, s)
+	}
+	return &PathError{path, last, ErrNotContainer}
+}
+
+func insertAt(s []any, path, seg string, v any) ([]any, error) {
+	i, err := pathIndex(seg, len(s), true)
+	if err != nil {
+		return nil, &PathError{path, seg, err}
+	}
+
+	out := make([]any, 0, len(s)+1)
+	out = append(out, s[:i]...)
+	out = append(out, v)
+	return append(out, s[i:]...), nil
+}
+
+// Diff returns the JSON Patch turning the map into "other". Slices that
+// differ are replaced as a whole.
+func (m Map) Diff(other map[string]any) Patch {
+	return diffMaps("", m, other)
+}
+
+func diffMaps(prefix string, a, b map[string]any) (out Patch) {
+	for _, k := range sortedKeys(a) {
+		if _, ok := b[k]; !ok {
+			out = append(out, PatchOp{Op: "remove", Path: prefix + "/" + escapePointer(k)})
+		}
+	}
+
+	for _, k := range sortedKeys(b) {
+		path := prefix + "/" + escapePointer(k)
+		old, ok := a[k]
+		if !ok {
+			out = append(out, PatchOp{Op: "add", Path: path, Value: b[k]})
+			continue
+		}
+
+		om, oerr := ToMap(old)
+		nm, nerr := ToMap(b[k])
+		if oerr == nil && nerr == nil && om != nil && nm != nil {
+			out = append(out, diffMaps(path, om, nm)...)
+		} else if !Equal(old, b[k]) {
+			out = append(out, PatchOp{Op: "replace", Path: path, Value: b[k]})
+		}
+	}
+	return
+}
+
+// escapePointer escapes a key to be used in a JSON Pointer.
+func escapePointer(k string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(k)
+}
+
+func sortedKeys(m map[string]any) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Equal says if "a" and "b" hold the same JSON value, numbers are equal
+// when their values are, whatever their kinds.
+func Equal(a, b any) bool {
+	if am, err := ToMap(a); err == nil {
+		bm, err := ToMap(b)
+		if err != nil || len(am) != len(bm) {
+			return false
+		}
+		for k, v := range am {
+			w, ok := bm[k]
+			if !ok || !Equal(v, w) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if isNumber(a) && isNumber(b) {
+		return numberEqual(a, b)
+	}
+
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if isSlice(a) && isSlice(b) {
+		if av.Len() != bv.Len() {
+			return false
+		}
+		for i := 0; i < av.Len(); i++ {
+			if !Equal(av.Index(i).Interface(), bv.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func isNumber(v any) bool {
+	if _, ok := v.(json.Number); ok {
+		return true
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func numberEqual(a, b any) bool {
+	if ai, err := ToInt64(a); err == nil {
+		bi, err := ToInt64(b)
+		return err == nil && ai == bi
+	}
+	if au, err := ToUint64(a); err == nil {
+		bu, err := ToUint64(b)
+		return err == nil && au == bu
+	}
+
+	af, aerr := ToFloat64(a)
+	bf, berr := ToFloat64(b)
+	return aerr == nil && berr == nil && af == bf
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makePatchDoc(t *testing.T, s string) Map {
+	m := Map{}
+	assert.NoError(t, json.Unmarshal([]byte(s), &m))
+	return m
+}
+
+func TestMap_ApplyMergePatch(t *testing.T) {
+	m := makePatchDoc(t, `{"title":"Goodbye!","author":{"givenName":"John","familyName":"Doe"},"tags":["example","sample"],"content":"This will be unchanged"}`)
+	patch := makePatchDoc(t, `{"title":"Hello!","phoneNumber":"+01-123-456-7890","author":{"familyName":null},"tags":["example"],"extra":{"a":null,"b":1}}`)
+
+	m.ApplyMergePatch(patch)
+	expected := makePatchDoc(t, `{"title":"Hello!","author":{"givenName":"John"},"tags":["example"],"content":"This will be unchanged","phoneNumber":"+01-123-456-7890","extra":{"b":1}}`)
+	assert.True(t, Equal(expected, m), "%v", m)
+}
+
+func TestMap_ApplyPatch(t *testing.T) {
+	m := makePatchDoc(t, `{"foo":["bar","baz"],"obj":{"a":1},"n":1}`)
+
+	patch := Patch{}
+	assert.NoError(t, json.Unmarshal([]byte(`[
+		{"op":"add","path":"/foo/1","value":"qux"},
+		{"op":"add","path":"/foo/-","value":"end"},
+		{"op":"remove","path":"/obj/a"},
+		{"op":"replace","path":"/n","value":2},
+		{"op":"copy","from":"/foo/0","path":"/obj/first"},
+		{"op":"move","from":"/n","path":"/obj/n"},
+		{"op":"test","path":"/obj/n","value":2}
+	]`), &patch))
+
+	assert.NoError(t, m.ApplyPatch(patch))
+	expected := makePatchDoc(t, `{"foo":["bar","qux","baz","end"],"obj":{"first":"bar","n":2}}`)
+	assert.True(t, Equal(expected, m), "%v", m)
+}
+
+func TestMap_ApplyPatchErrors(t *testing.T) {
+	m := makePatchDoc(t, `{"a":{"b":1},"list":[1]}`)
+	original := Map(copyMap(m))
+
+	err := m.ApplyPatch(Patch{
+		{Op: "add", Path: "/c", Value: 3},
+		{Op: "test", Path: "/a/b", Value: 2},
+	})
+	assert.True(t, errors.Is(err, ErrTestFailed))
+	assert.Equal(t, original, m)
+
+	assert.True(t, errors.Is(m.ApplyPatch(Patch{{Op: "replace", Path: "/missing", Value: 1}}), ErrKeyNotFound))
+	assert.True(t, errors.Is(m.ApplyPatch(Patch{{Op: "add", Path: "/list/5", Value: 1}}), ErrIndexOutOfRange))
+	assert.True(t, errors.Is(m.ApplyPatch(Patch{{Op: "move", From: "/a", Path: "/a/b/c"}}), ErrInvalidPatch))
+	assert.True(t, errors.Is(m.ApplyPatch(Patch{{Op: "nope", Path: "/a"}}), ErrInvalidPatch))
+}
+
+func TestMap_Diff(t *testing.T) {
+	a := makePatchDoc(t, `{"a":1,"b":{"c":"x","d":[1,2]},"e~f":true}`)
+	b := makePatchDoc(t, `{"a":1,"b":{"c":"y","d":[1,2,3],"g":null}}`)
+
+	patch := a.Diff(b)
+	assert.Equal(t, Patch{
+		{Op: "remove", Path: "/e~0f"},
+		{Op: "replace", Path: "/b/c", Value: "y"},
+		{Op: "replace", Path: "/b/d", Value: []any{float64(1), float64(2), float64(3)}},
+		{Op: "add", Path: "/b/g", Value: nil},
+	}, patch)
+
+	raw, err := json.Marshal(patch[3])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"op":"add","path":"/b/g","value":null}`, string(raw))
+
+	assert.NoError(t, a.ApplyPatch(patch))
+	assert.True(t, Equal(b, a))
+	assert.Empty(t, a.Diff(b))
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal(1, float64(1)))
+	assert.True(t, Equal(uint64(3), json.Number("3")))
+	assert.False(t, Equal(1, 1.5))
+	assert.True(t, Equal(Map{"a": []any{1}}, map[string]any{"a": Ints{1}}))
+	assert.False(t, Equal(Map{"a": 1}, Map{"b": 1}))
+}
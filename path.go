@@ -0,0 +1,375 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidPath is returned when a path or a JSON Pointer can't be parsed.
+	ErrInvalidPath = errors.New("types: invalid path")
+
+	// ErrIndexOutOfRange is returned when a slice index doesn't exist.
+	ErrIndexOutOfRange = errors.New("types: index out of range")
+
+	// ErrNotContainer is returned when a path goes through a value that is
+	// neither a map nor a slice.
+	ErrNotContainer = errors.New("types: value is not a map or a slice")
+)
+
+// PathError is returned when a path can't be resolved.
+type PathError struct {
+	// Path is the full path.
+	Path string
+
+	// Segment is the segment where the resolution failed.
+	Segment string
+
+	// Err is the cause of the failure.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *PathError) Error() string {
+	return fmt.Sprintf("types: path %q at %q: %v", e.Path, e.Segment, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// ParsePath splits a dotted path like "items[0].id" or "items.0.id" into segments.
+func ParsePath(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	out := []string{}
+	for _, part := range strings.Split(path, ".") {
+		name, rest := part, ""
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			name, rest = part[:i], part[i:]
+		}
+		if name == "" && rest == "" {
+			return nil, fmt.Errorf("%w: empty segment in %q", ErrInvalidPath, path)
+		}
+		if name != "" {
+			out = append(out, name)
+		}
+
+		for rest != "" {
+			end := strings.IndexByte(rest, ']')
+			if rest[0] != '[' || end < 2 {
+				return nil, fmt.Errorf("%w: malformed index in %q", ErrInvalidPath, path)
+			}
+			out = append(out, rest[1:end])
+			rest = rest[end+1:]
+		}
+	}
+	return out, nil
+}
+
+// ParsePointer splits a RFC 6901 JSON Pointer like "/items/0/id" into segments.
+func ParsePointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if ptr[0] != '/' {
+		return nil, fmt.Errorf("%w: JSON Pointer %q must start with /", ErrInvalidPath, ptr)
+	}
+
+	out := strings.Split(ptr[1:], "/")
+	for i, seg := range out {
+		out[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(seg)
+	}
+	return out, nil
+}
+
+// Path get the value at the dotted path, see ParsePath.
+func (m Map) Path(path string) (any, bool) {
+	segs, err := ParsePath(path)
+	if err != nil {
+		return nil, false
+	}
+	v, err := m.lookup(path, segs)
+	return v, err == nil
+}
+
+// PathE is like Path but returns why the value couldn't be found.
+func (m Map) PathE(path string) (any, error) {
+	segs, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return m.lookup(path, segs)
+}
+
+// SetPath set the value at the dotted path, intermediate maps are created.
+func (m Map) SetPath(path string, v any) error {
+	segs, err := ParsePath(path)
+	if err != nil {
+		return err
+	}
+	return m.set(path, segs, v)
+}
+
+// DeletePath delete the value at the dotted path and say if it existed.
+func (m Map) DeletePath(path string) bool {
+	segs, err := ParsePath(path)
+	if err != nil {
+		return false
+	}
+	return m.delete(path, segs) == nil
+}
+
+// Pointer get the value at the RFC 6901 JSON Pointer.
+func (m Map) Pointer(ptr string) (any, bool) {
+	v, err := m.PointerE(ptr)
+	return v, err == nil
+}
+
+// PointerE is like Pointer but returns why the value couldn't be found.
+func (m Map) PointerE(ptr string) (any, error) {
+	segs, err := ParsePointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+	return m.lookup(ptr, segs)
+}
+
+// SetPointer set the value at the RFC 6901 JSON Pointer, intermediate maps are
+// created and "-" appends to a slice.
+func (m Map) SetPointer(ptr string, v any) error {
+	segs, err := ParsePointer(ptr)
+	if err != nil {
+		return err
+	}
+	return m.set(ptr, segs, v)
+}
+
+// DeletePointer delete the value at the RFC 6901 JSON Pointer and say if it existed.
+func (m Map) DeletePointer(ptr string) bool {
+	segs, err := ParsePointer(ptr)
+	if err != nil {
+		return false
+	}
+	return m.delete(ptr, segs) == nil
+}
+
+func (m Map) lookup(path string, segs []string) (any, error) {
+	var cur any = m
+	for _, seg := range segs {
+		next, err := pathChild(cur, seg)
+		if err != nil {
+			return nil, &PathError{path, seg, err}
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+func (m Map) set(path string, segs []string, v any) error {
+	if len(segs) == 0 {
+		return &PathError{path, "", ErrInvalidPath}
+	}
+	_, err := setIn(m, path, segs, v)
+	return err
+}
+
+func (m Map) delete(path string, segs []string) error {
+	if len(segs) == 0 {
+		return &PathError{path, "", ErrInvalidPath}
+	}
+	_, err := deleteIn(m, path, segs)
+	return err
+}
+
+// pathChild returns the element "seg" of the container "v".
+func pathChild(v any, seg string) (any, error) {
+	switch c := v.(type) {
+	case Map:
+		return mapChild(c, seg)
+	case map[string]any:
+		return mapChild(c, seg)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, ErrNotContainer
+		}
+		e := rv.MapIndex(reflect.ValueOf(seg).Convert(rv.Type().Key()))
+		if !e.IsValid() {
+			return nil, ErrKeyNotFound
+		}
+		return e.Interface(), nil
+	case reflect.Slice, reflect.Array:
+		i, err := pathIndex(seg, rv.Len(), false)
+		if err != nil {
+			return nil, err
+		}
+		return rv.Index(i).Interface(), nil
+	}
+	return nil, ErrNotContainer
+}
+
+func mapChild(m map[string]any, seg string) (any, error) {
+	v, ok := m[seg]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return v, nil
+}
+
+// pathIndex parses the slice index "seg", "-" is the index after the last element
+// when "appending" is true.
+func pathIndex(seg string, n int, appending bool) (int, error) {
+	if appending && seg == "-" {
+		return n, nil
+	}
+
+	i, err := strconv.Atoi(seg)
+	if err != nil || i < 0 || (seg != "0" && seg[0] == '0') {
+		return 0, fmt.Errorf("%w: %q is not an index", ErrInvalidPath, seg)
+	}
+	if i > n || (i == n && !appending) {
+		return 0, ErrIndexOutOfRange
+	}
+	return i, nil
+}
+
+// setIn set "v" at "segs" under "cur" and returns the updated container,
+// which differs from "cur" when a slice grows.
+func setIn(cur any, path string, segs []string, v any) (any, error) {
+	switch c := cur.(type) {
+	case Map:
+		return c, setInMap(c, path, segs, v)
+	case map[string]any:
+		return c, setInMap(c, path, segs, v)
+	case Slice:
+		out, err := setInSlice([]any(c), path, segs, v)
+		return Slice(out), err
+	case []any:
+		return setInSlice(c, path, segs, v)
+	}
+
+	return cur, &PathError{path, segs[0], ErrNotContainer}
+}
+
+func setInMap(m map[string]any, path string, segs []string, v any) error {
+	seg := segs[0]
+	if len(segs) == 1 {
+		m[seg] = v
+		return nil
+	}
+
+	next, ok := m[seg]
+	if !ok || next == nil {
+		next = Map{}
+	}
+
+	next, err := setIn(next, path, segs[1:], v)
+	if err != nil {
+		return err
+	}
+	m[seg] = next
+	return nil
+}
+
+func setInSlice(s []any, path string, segs []string, v any) ([]any, error) {
+	seg := segs[0]
+	i, err := pathIndex(seg, len(s), true)
+	if err != nil {
+		return s, &PathError{path, seg, err}
+	}
+
+	if len(segs) == 1 {
+		if i == len(s) {
+			return append(s, v), nil
+		}
+		s[i] = v
+		return s, nil
+	}
+
+	var next any = Map{}
+	if i < len(s) && s[i] != nil {
+		next = s[i]
+	}
+
+	next, err = setIn(next, path, segs[1:], v)
+	if err != nil {
+		return s, err
+	}
+	if i == len(s) {
+		return append(s, next), nil
+	}
+	s[i] = next
+	return s, nil
+}
+
+// deleteIn delete "segs" under "cur" and returns the updated container,
+// which differs from "cur" when a slice shrinks.
+func deleteIn(cur any, path string, segs []string) (any, error) {
+	seg := segs[0]
+	switch c := cur.(type) {
+	case Map:
+		return c, deleteInMap(c, path, segs)
+	case map[string]any:
+		return c, deleteInMap(c, path, segs)
+	case Slice:
+		out, err := deleteInSlice([]any(c), path, segs)
+		return Slice(out), err
+	case []any:
+		return deleteInSlice(c, path, segs)
+	}
+	return cur, &PathError{path, seg, ErrNotContainer}
+}
+
+func deleteInMap(m map[string]any, path string, segs []string) error {
+	seg := segs[0]
+	next, ok := m[seg]
+	if !ok {
+		return &PathError{path, seg, ErrKeyNotFound}
+	}
+
+	if len(segs) == 1 {
+		delete(m, seg)
+		return nil
+	}
+
+	next, err := deleteIn(next, path, segs[1:])
+	if err != nil {
+		return err
+	}
+	m[seg] = next
+	return nil
+}
+
+func deleteInSlice(s []any, path string, segs []string) ([]any, error) {
+	seg := segs[0]
+	i, err := pathIndex(seg, len(s), false)
+	if err != nil {
+		return s, &PathError{path, seg, err}
+	}
+
+	if len(segs) == 1 {
+		return append(s[:i], s[i+1:]...), nil
+	}
+
+	next, err := deleteIn(s[i], path, segs[1:])
+	if err != nil {
+		return s, err
+	}
+	s[i] = next
+	return s, nil
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makePathMap(t *testing.T) Map {
+	m := Map{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"user": {"address": {"city": "Paris"}, "tags": ["a", "b"]},
+		"items": [{"id": 1}, {"id": 2}],
+		"a/b": {"m~n": true}
+	}`), &m))
+	return m
+}
+
+func TestParsePath(t *testing.T) {
+	segs, err := ParsePath("items[0].id")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"items", "0", "id"}, segs)
+
+	segs, err = ParsePath("matrix[1][2]")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"matrix", "1", "2"}, segs)
+
+	for _, p := range []string{"a..b", "a[", "a[]", "a[0]b"} {
+		_, err = ParsePath(p)
+		assert.True(t, errors.Is(err, ErrInvalidPath), p)
+	}
+}
+
+func TestMap_Path(t *testing.T) {
+	m := makePathMap(t)
+
+	v, ok := m.Path("user.address.city")
+	assert.True(t, ok)
+	assert.Equal(t, "Paris", v)
+
+	v, ok = m.Path("items[1].id")
+	assert.True(t, ok)
+	assert.Equal(t, float64(2), v)
+
+	v, ok = m.Path("user.tags.0")
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+
+	_, ok = m.Path("items[2].id")
+	assert.False(t, ok)
+
+	_, err := m.PathE("user.address.city.name")
+	assert.True(t, errors.Is(err, ErrNotContainer))
+
+	m2 := Map{"list": Slice{Map{"ok": true}}, "strings": Strings{"x"}}
+	v, ok = m2.Path("list[0].ok")
+	assert.True(t, ok)
+	assert.Equal(t, true, v)
+
+	v, ok = m2.Path("strings[0]")
+	assert.True(t, ok)
+	assert.Equal(t, "x", v)
+}
+
+func TestMap_Pointer(t *testing.T) {
+	m := makePathMap(t)
+
+	v, ok := m.Pointer("/a~1b/m~0n")
+	assert.True(t, ok)
+	assert.Equal(t, true, v)
+
+	v, ok = m.Pointer("/items/0/id")
+	assert.True(t, ok)
+	assert.Equal(t, float64(1), v)
+
+	v, ok = m.Pointer("")
+	assert.True(t, ok)
+	assert.Equal(t, m, v)
+
+	_, err := m.PointerE("items")
+	assert.True(t, errors.Is(err, ErrInvalidPath))
+}
+
+func TestMap_SetPath(t *testing.T) {
+	m := makePathMap(t)
+
+	assert.NoError(t, m.SetPath("user.address.zip", "75001"))
+	assert.Equal(t, "75001", m.Map("user").Map("address").String("zip"))
+
+	assert.NoError(t, m.SetPath("db.pool.size", 10))
+	v, _ := m.Path("db.pool.size")
+	assert.Equal(t, 10, v)
+
+	assert.NoError(t, m.SetPath("items[1].id", 3))
+	v, _ = m.Path("items[1].id")
+	assert.Equal(t, 3, v)
+
+	assert.NoError(t, m.SetPointer("/user/tags/-", "c"))
+	assert.Equal(t, Strings{"a", "b", "c"}, m.Map("user").Strings("tags"))
+
+	err := m.SetPath("user.address.city.name", "x")
+	assert.True(t, errors.Is(err, ErrNotContainer))
+
+	err = m.SetPath("items[5].id", 1)
+	assert.True(t, errors.Is(err, ErrIndexOutOfRange))
+}
+
+func TestMap_DeletePath(t *testing.T) {
+	m := makePathMap(t)
+
+	assert.True(t, m.DeletePath("user.address.city"))
+	assert.Equal(t, Map{}, m.Map("user").Map("address"))
+
+	assert.True(t, m.DeletePointer("/user/tags/0"))
+	assert.Equal(t, Strings{"b"}, m.Map("user").Strings("tags"))
+
+	assert.False(t, m.DeletePath("user.missing"))
+	assert.False(t, m.DeletePath(""))
+}
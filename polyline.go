@@ -7,6 +7,7 @@ package types
 
 import (
 	"database/sql/driver"
+	"fmt"
 
 	"github.com/twpayne/go-polyline"
 )
@@ -37,3 +38,18 @@ func (p Polyline) String() string {
 func (p Polyline) Value() (driver.Value, error) {
 	return p.String(), nil
 }
+
+// Scan implements the sql.Scanner interface.
+func (p *Polyline) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*p = ""
+	case []byte:
+		*p = Polyline(v)
+	case string:
+		*p = Polyline(v)
+	default:
+		return fmt.Errorf("types: cannot scan %T into Polyline", src)
+	}
+	return nil
+}
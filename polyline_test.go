@@ -1 +1,19 @@
 package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolyline_Scan(t *testing.T) {
+	p := Polyline("")
+
+	assert.NoError(t, fakeScan(t, "_p~iF~ps|U", &p))
+	assert.Equal(t, Polyline("_p~iF~ps|U"), p)
+
+	assert.NoError(t, fakeScan(t, []byte("_ulLnnqC"), &p))
+	assert.Equal(t, Polyline("_ulLnnqC"), p)
+
+	assert.Error(t, fakeScan(t, int64(1), &p))
+}
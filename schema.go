@@ -0,0 +1,396 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrRequired is returned when a required key is missing.
+	ErrRequired = errors.New("types: required key is missing")
+
+	// ErrType is returned when a value doesn't have the expected type.
+	ErrType = errors.New("types: unexpected type")
+
+	// ErrRange is returned when a number is out of the allowed range.
+	ErrRange = errors.New("types: out of range")
+
+	// ErrLength is returned when a string or an array has a wrong length.
+	ErrLength = errors.New("types: invalid length")
+
+	// ErrPattern is returned when a string doesn't match the pattern.
+	ErrPattern = errors.New("types: doesn't match the pattern")
+
+	// ErrEnum is returned when a value isn't one of the allowed values.
+	ErrEnum = errors.New("types: not an allowed value")
+
+	// ErrUnknownKey is returned when a strict schema meets an undeclared key.
+	ErrUnknownKey = errors.New("types: unknown key")
+
+	// ErrCheck is returned when a custom check fails.
+	ErrCheck = errors.New("types: check failed")
+)
+
+// SchemaType is the type of a value described by a Schema.
+type SchemaType int
+
+// The types of values, TypeInteger also accepts floats without a fraction.
+const (
+	TypeString SchemaType = iota + 1
+	TypeNumber
+	TypeInteger
+	TypeBool
+	TypeObject
+	TypeArray
+	TypeNull
+)
+
+var schemaTypeNames = map[SchemaType]string{
+	TypeString:  "string",
+	TypeNumber:  "number",
+	TypeInteger: "integer",
+	TypeBool:    "boolean",
+	TypeObject:  "object",
+	TypeArray:   "array",
+	TypeNull:    "null",
+}
+
+// String implements the fmt.Stringer interface, names are the JSON Schema ones.
+func (t SchemaType) String() string {
+	if name, ok := schemaTypeNames[t]; ok {
+		return name
+	}
+	return "SchemaType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// match says if "v" has the type "t".
+func (t SchemaType) match(v any) bool {
+	switch t {
+	case TypeString:
+		return reflect.ValueOf(v).Kind() == reflect.String && !isNumber(v)
+	case TypeNumber:
+		return isNumber(v)
+	case TypeInteger:
+		f, err := ToFloat64(v)
+		return isNumber(v) && err == nil && f == math.Trunc(f)
+	case TypeBool:
+		return reflect.ValueOf(v).Kind() == reflect.Bool
+	case TypeObject:
+		_, err := ToMap(v)
+		return err == nil
+	case TypeArray:
+		_, ok := v.([]byte)
+		return !ok && isSlice(v)
+	case TypeNull:
+		return v == nil
+	}
+	return false
+}
+
+// Schema describes the expected shape of a value, it is built by chaining
+// its methods and checked with Map.Validate.
+type Schema struct {
+	types        []SchemaType
+	keys         map[string]*Schema
+	required     []string
+	strict       bool
+	items        *Schema
+	min, max     *float64
+	exclusiveMin bool
+	exclusiveMax bool
+	minLen       *int
+	maxLen       *int
+	minItems     *int
+	maxItems     *int
+	pattern      *regexp.Regexp
+	enum         []any
+	constant     any
+	hasConst     bool
+	checks       []schemaCheck
+}
+
+type schemaCheck struct {
+	name    string
+	matcher Matcher
+}
+
+// NewSchema returns a schema accepting one of the types "types", any type
+// is accepted when none is given.
+func NewSchema(types ...SchemaType) *Schema {
+	return &Schema{types: types}
+}
+
+// Key declares the schema of the key "k" of an object.
+func (s *Schema) Key(k string, schema *Schema) *Schema {
+	if s.keys == nil {
+		s.keys = map[string]*Schema{}
+	}
+	s.keys[k] = schema
+	return s
+}
+
+// Required declares the keys that must exist in an object.
+func (s *Schema) Required(keys ...string) *Schema {
+	s.required = append(s.required, keys...)
+	return s
+}
+
+// Strict rejects the keys of an object that aren't declared with Key.
+func (s *Schema) Strict() *Schema {
+	s.strict = true
+	return s
+}
+
+// Items declares the schema of the elements of an array.
+func (s *Schema) Items(schema *Schema) *Schema {
+	s.items = schema
+	return s
+}
+
+// Min sets the minimum of a number.
+func (s *Schema) Min(f float64) *Schema {
+	s.min, s.exclusiveMin = &f, false
+	return s
+}
+
+// Max sets the maximum of a number.
+func (s *Schema) Max(f float64) *Schema {
+	s.max, s.exclusiveMax = &f, false
+	return s
+}
+
+// ExclusiveMin sets the exclusive minimum of a number.
+func (s *Schema) ExclusiveMin(f float64) *Schema {
+	s.min, s.exclusiveMin = &f, true
+	return s
+}
+
+// ExclusiveMax sets the exclusive maximum of a number.
+func (s *Schema) ExclusiveMax(f float64) *Schema {
+	s.max, s.exclusiveMax = &f, true
+	return s
+}
+
+// MinLen sets the minimum length of a string, in runes.
+func (s *Schema) MinLen(n int) *Schema {
+	s.minLen = &n
+	return s
+}
+
+// MaxLen sets the maximum length of a string, in runes.
+func (s *Schema) MaxLen(n int) *Schema {
+	s.maxLen = &n
+	return s
+}
+
+// MinItems sets the minimum number of elements of an array.
+func (s *Schema) MinItems(n int) *Schema {
+	s.minItems = &n
+	return s
+}
+
+// MaxItems sets the maximum number of elements of an array.
+func (s *Schema) MaxItems(n int) *Schema {
+	s.maxItems = &n
+	return s
+}
+
+// Pattern sets the regular expression a string must match, it panics if
+// "expr" can't be compiled.
+func (s *Schema) Pattern(expr string) *Schema {
+	s.pattern = regexp.MustCompile(expr)
+	return s
+}
+
+// Enum sets the allowed values, compared with Equal.
+func (s *Schema) Enum(values ...any) *Schema {
+	s.enum = append(s.enum, values...)
+	return s
+}
+
+// Const sets the only allowed value, compared with Equal. It is checked on
+// top of Enum, a value must then satisfy both.
+func (s *Schema) Const(v any) *Schema {
+	s.constant, s.hasConst = v, true
+	return s
+}
+
+// Check adds a custom check named "name", the matcher is called with the
+// path and the value.
+func (s *Schema) Check(name string, matcher Matcher) *Schema {
+	s.checks = append(s.checks, schemaCheck{name, matcher})
+	return s
+}
+
+// ValidationError lists every violation of a schema.
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("types: %d violation(s): %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of every violation.
+func (e *ValidationError) Unwrap() []error {
+	out := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		out[i] = err
+	}
+	return out
+}
+
+// Validate checks the map against the schema, every violation is returned
+// in a *ValidationError with its dotted path.
+func (m Map) Validate(schema *Schema) error {
+	v := validator{}
+	v.validate("", m, schema)
+	if len(v.errs) > 0 {
+		return &ValidationError{v.errs}
+	}
+	return nil
+}
+
+type validator struct {
+	errs []*FieldError
+}
+
+func (v *validator) fail(path string, err error) {
+	v.errs = append(v.errs, &FieldError{path, err})
+}
+
+func (v *validator) validate(path string, value any, s *Schema) {
+	if len(s.types) > 0 && !s.matchType(value) {
+		v.fail(path, fmt.Errorf("%w: expected %s, got %T", ErrType, joinTypes(s.types), value))
+		return
+	}
+
+	if len(s.enum) > 0 && !s.matchEnum(value) {
+		v.fail(path, fmt.Errorf("%w: %v", ErrEnum, value))
+	}
+	if s.hasConst && !Equal(value, s.constant) {
+		v.fail(path, fmt.Errorf("%w: %v isn't %v", ErrEnum, value, s.constant))
+	}
+
+	switch {
+	case isNumber(value):
+		v.validateNumber(path, value, s)
+	case reflect.ValueOf(value).Kind() == reflect.String:
+		str := reflect.ValueOf(value).String()
+		v.validateLen(path, utf8.RuneCountInString(str), s.minLen, s.maxLen)
+		if s.pattern != nil && !s.pattern.MatchString(str) {
+			v.fail(path, fmt.Errorf("%w %q", ErrPattern, s.pattern))
+		}
+	case TypeObject.match(value):
+		v.validateObject(path, value, s)
+	case TypeArray.match(value):
+		rv := reflect.ValueOf(value)
+		v.validateLen(path, rv.Len(), s.minItems, s.maxItems)
+		if s.items != nil {
+			for i := 0; i < rv.Len(); i++ {
+				v.validate(path+"["+strconv.Itoa(i)+"]", rv.Index(i).Interface(), s.items)
+			}
+		}
+	}
+
+	for _, c := range s.checks {
+		if !c.matcher(path, value) {
+			v.fail(path, fmt.Errorf("%w: %s", ErrCheck, c.name))
+		}
+	}
+}
+
+func (v *validator) validateNumber(path string, value any, s *Schema) {
+	f, err := ToFloat64(value)
+	if err != nil {
+		return
+	}
+
+	if s.min != nil && (f < *s.min || (s.exclusiveMin && f == *s.min)) {
+		v.fail(path, fmt.Errorf("%w: %v is lower than %v", ErrRange, value, *s.min))
+	}
+	if s.max != nil && (f > *s.max || (s.exclusiveMax && f == *s.max)) {
+		v.fail(path, fmt.Errorf("%w: %v is greater than %v", ErrRange, value, *s.max))
+	}
+}
+
+func (v *validator) validateLen(path string, n int, min, max *int) {
+	if min != nil && n < *min {
+		v.fail(path, fmt.Errorf("%w: %d is lower than %d", ErrLength, n, *min))
+	}
+	if max != nil && n > *max {
+		v.fail(path, fmt.Errorf("%w: %d is greater than %d", ErrLength, n, *max))
+	}
+}
+
+func (v *validator) validateObject(path string, value any, s *Schema) {
+	m, _ := ToMap(value)
+	for _, k := range s.required {
+		if _, ok := m[k]; !ok {
+			v.fail(joinPath(path, k), ErrRequired)
+		}
+	}
+
+	keys := make([]string, 0, len(s.keys))
+	for k := range s.keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if e, ok := m[k]; ok {
+			v.validate(joinPath(path, k), e, s.keys[k])
+		}
+	}
+
+	if s.strict {
+		for _, k := range sortedKeys(m) {
+			if _, ok := s.keys[k]; !ok {
+				v.fail(joinPath(path, k), ErrUnknownKey)
+			}
+		}
+	}
+}
+
+func (s *Schema) matchType(v any) bool {
+	for _, t := range s.types {
+		if t.match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Schema) matchEnum(v any) bool {
+	for _, e := range s.enum {
+		if Equal(v, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinTypes(types []SchemaType) string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	return strings.Join(names, " or ")
+}
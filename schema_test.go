@@ -0,0 +1,206 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeUserSchema() *Schema {
+	return NewSchema(TypeObject).
+		Required("name", "age", "address").
+		Key("name", NewSchema(TypeString).MinLen(2).Pattern(`^[a-z]+$`)).
+		Key("age", NewSchema(TypeInteger).Min(0).Max(150)).
+		Key("role", NewSchema(TypeString).Enum("admin", "user")).
+		Key("email", NewSchema(TypeString, TypeNull).Check("email", func(_ string, v any) bool {
+			s, _ := v.(string)
+			return v == nil || strings.Contains(s, "@")
+		})).
+		Key("address", NewSchema(TypeObject).Strict().
+			Required("city").
+			Key("city", NewSchema(TypeString)).
+			Key("zip", NewSchema(TypeString).MaxLen(5))).
+		Key("tags", NewSchema(TypeArray).MaxItems(2).Items(NewSchema(TypeString)))
+}
+
+func TestMap_Validate(t *testing.T) {
+	m := Map{
+		"name":    "john",
+		"age":     42.0,
+		"role":    "admin",
+		"email":   nil,
+		"address": map[string]any{"city": "Paris", "zip": "75001"},
+		"tags":    Strings{"a", "b"},
+	}
+	assert.NoError(t, m.Validate(makeUserSchema()))
+
+	assert.NoError(t, Map{}.Validate(NewSchema()))
+}
+
+func TestMap_ValidateViolations(t *testing.T) {
+	m := Map{
+		"name":    "J",
+		"age":     200,
+		"role":    "root",
+		"email":   "nope",
+		"address": Map{"zip": "750011", "country": "FR"},
+		"tags":    []any{"a", 1, "c"},
+	}
+
+	err := m.Validate(makeUserSchema())
+	var verr *ValidationError
+	assert.ErrorAs(t, err, &verr)
+
+	paths := []string{}
+	for _, e := range verr.Errors {
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{
+		"address.city",
+		"address.zip",
+		"address.country",
+		"age",
+		"email",
+		"name",
+		"name",
+		"role",
+		"tags",
+		"tags[1]",
+	}, paths)
+
+	for i, target := range []error{
+		ErrRequired, ErrLength, ErrUnknownKey, ErrRange, ErrCheck,
+		ErrLength, ErrPattern, ErrEnum, ErrLength, ErrType,
+	} {
+		assert.ErrorIs(t, verr.Errors[i], target, verr.Errors[i].Path)
+	}
+	assert.ErrorIs(t, err, ErrRequired)
+
+	err = Map{"age": "42"}.Validate(makeUserSchema())
+	assert.True(t, errors.Is(err, ErrType))
+	assert.Contains(t, err.Error(), "age: types: unexpected type: expected integer, got string")
+}
+
+func TestSchema_Bounds(t *testing.T) {
+	s := NewSchema().Key("n", NewSchema(TypeNumber).ExclusiveMin(0).ExclusiveMax(1))
+	assert.NoError(t, Map{"n": 0.5}.Validate(s))
+	assert.ErrorIs(t, Map{"n": 0}.Validate(s), ErrRange)
+	assert.ErrorIs(t, Map{"n": uint8(1)}.Validate(s), ErrRange)
+
+	s = NewSchema().Key("n", NewSchema(TypeInteger))
+	assert.NoError(t, Map{"n": 3.0}.Validate(s))
+	assert.ErrorIs(t, Map{"n": 3.5}.Validate(s), ErrType)
+}
+
+func TestParseJSONSchema(t *testing.T) {
+	s, err := ParseJSONSchema([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "User",
+		"type": "object",
+		"required": ["name", "address"],
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "pattern": "^[a-z]+$"},
+			"age": {"type": "integer", "minimum": 0, "exclusiveMaximum": 150},
+			"role": {"enum": ["admin", "user"]},
+			"kind": {"const": "person"},
+			"email": {"type": ["string", "null"]},
+			"address": {
+				"type": "object",
+				"additionalProperties": false,
+				"required": ["city"],
+				"properties": {"city": {"type": "string"}}
+			},
+			"tags": {"type": "array", "maxItems": 2, "items": {"type": "string"}},
+			"never": false
+		}
+	}`))
+	assert.NoError(t, err)
+
+	assert.NoError(t, Map{
+		"name":    "john",
+		"age":     149,
+		"role":    "user",
+		"kind":    "person",
+		"email":   nil,
+		"address": Map{"city": "Paris"},
+		"tags":    []any{"a"},
+	}.Validate(s))
+
+	err = Map{
+		"name":    "J",
+		"age":     150,
+		"role":    "root",
+		"kind":    "robot",
+		"email":   1,
+		"address": Map{"zip": "75001"},
+		"tags":    []any{"a", "b", "c"},
+		"never":   1,
+	}.Validate(s)
+
+	var verr *ValidationError
+	assert.ErrorAs(t, err, &verr)
+	assert.Len(t, verr.Errors, 10)
+}
+
+func TestSchema_JSONNumber(t *testing.T) {
+	// json.Number is a number, not a string.
+	s := NewSchema(TypeObject).Key("v", NewSchema(TypeString))
+	assert.ErrorIs(t, Map{"v": json.Number("42")}.Validate(s), ErrType)
+
+	s = NewSchema(TypeObject).Key("v", NewSchema(TypeInteger).Max(10))
+	assert.ErrorIs(t, Map{"v": json.Number("42")}.Validate(s), ErrRange)
+}
+
+func TestParseJSONSchemaConst(t *testing.T) {
+	// enum and const must both hold.
+	s, err := ParseJSONSchema([]byte(`{"properties": {"v": {"enum": [1, 2], "const": 3}}}`))
+	assert.NoError(t, err)
+	for _, v := range []any{1, 2, 3} {
+		assert.ErrorIs(t, Map{"v": v}.Validate(s), ErrEnum, v)
+	}
+
+	s, err = ParseJSONSchema([]byte(`{"properties": {"v": {"enum": [1, 2], "const": 2}}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, Map{"v": 2}.Validate(s))
+	assert.ErrorIs(t, Map{"v": 1}.Validate(s), ErrEnum)
+}
+
+func TestParseJSONSchemaLength(t *testing.T) {
+	// minLength only applies to strings, minItems only to arrays.
+	s, err := ParseJSONSchema([]byte(`{"properties": {"v": {"minLength": 2}}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, Map{"v": []any{1}}.Validate(s))
+	assert.ErrorIs(t, Map{"v": "a"}.Validate(s), ErrLength)
+
+	s, err = ParseJSONSchema([]byte(`{"properties": {"v": {"type": ["string", "array"], "minLength": 5, "minItems": 1}}}`))
+	assert.NoError(t, err)
+	assert.NoError(t, Map{"v": []any{1}}.Validate(s))
+	assert.NoError(t, Map{"v": "hello"}.Validate(s))
+	assert.ErrorIs(t, Map{"v": []any{}}.Validate(s), ErrLength)
+	assert.ErrorIs(t, Map{"v": "hi"}.Validate(s), ErrLength)
+}
+
+func TestParseJSONSchemaErrors(t *testing.T) {
+	for _, doc := range []string{
+		`{"$ref": "#/$defs/user"}`,
+		`{"additionalProperties": {"type": "string"}}`,
+		`{"properties": {"a": {"oneOf": []}}}`,
+	} {
+		_, err := ParseJSONSchema([]byte(doc))
+		assert.ErrorIs(t, err, ErrUnsupportedSchema, doc)
+	}
+
+	for _, doc := range []string{
+		`{"type": "date"}`,
+		`{"minLength": -1}`,
+		`{"pattern": "("}`,
+		`{"enum": 1}`,
+		`{"items": 1}`,
+	} {
+		_, err := ParseJSONSchema([]byte(doc))
+		assert.ErrorIs(t, err, ErrInvalidSchema, doc)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bufio"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// SnapshotFormat is the encoding of a snapshot.
+type SnapshotFormat string
+
+const (
+	SnapshotJSON SnapshotFormat = "json"
+	SnapshotGob  SnapshotFormat = "gob"
+)
+
+// SnapshotVersion is the version of the snapshots written by this package.
+const SnapshotVersion = 1
+
+// snapshotMagic starts the header line of every snapshot.
+const snapshotMagic = "types-snapshot "
+
+var (
+	// ErrSnapshotHeader is returned when a snapshot has no valid header.
+	ErrSnapshotHeader = errors.New("types: invalid snapshot header")
+
+	// ErrSnapshotVersion is returned when a snapshot has an unknown version.
+	ErrSnapshotVersion = errors.New("types: unsupported snapshot version")
+
+	// ErrSnapshotKind is returned when a snapshot is restored into another type.
+	ErrSnapshotKind = errors.New("types: snapshot of another type")
+)
+
+func init() {
+	for _, v := range []any{
+		Map{}, map[string]any{}, &OrderedMap{}, Slice{}, []any{},
+		Strings{}, Ints{}, Int64s{}, Uints{}, Uint64s{}, Floats{}, Bools{},
+		Date{}, DateTime{}, DateYearMonth{}, NullDate{}, NullDateTime{}, NullDateYearMonth{},
+		Point{}, Points{}, Polyline(""),
+	} {
+		gob.Register(v)
+	}
+}
+
+// SnapshotHeader is the first line of a snapshot.
+type SnapshotHeader struct {
+	Version int            `json:"version"`
+	Format  SnapshotFormat `json:"format"`
+	Kind    string         `json:"kind"`
+}
+
+// Snapshotter is implemented by the thread-safe types.
+type Snapshotter interface {
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
+
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
+}
+
+// SnapshotFile writes the snapshot of "s" to the file "path" atomically: it
+// is written to a temporary file which is renamed once complete.
+func SnapshotFile(path string, s Snapshotter, format SnapshotFormat) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	if err = s.Snapshot(f, format); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// RestoreFile restores "s" from the snapshot in the file "path".
+func RestoreFile(path string, s Snapshotter) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return s.Restore(f)
+}
+
+// writeSnapshot writes the header then "v" encoded with "format".
+func writeSnapshot(w io.Writer, kind string, format SnapshotFormat, v any) error {
+	if format != SnapshotJSON && format != SnapshotGob {
+		return fmt.Errorf("types: unknown snapshot format %q", format)
+	}
+
+	header, err := json.Marshal(SnapshotHeader{SnapshotVersion, format, kind})
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(snapshotMagic)
+	bw.Write(header)
+	bw.WriteByte('\n')
+
+	if format == SnapshotGob {
+		err = gob.NewEncoder(bw).Encode(v)
+	} else {
+		err = json.NewEncoder(bw).Encode(v)
+	}
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// readSnapshot checks the header and decodes the snapshot into "v", JSON
+// numbers are decoded as json.Number.
+func readSnapshot(r io.Reader, kind string, v any) error {
+	br := bufio.NewReader(r)
+	line, err := br.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, snapshotMagic) {
+		return ErrSnapshotHeader
+	}
+
+	var header SnapshotHeader
+	if err := json.Unmarshal([]byte(line[len(snapshotMagic):]), &header); err != nil {
+		return fmt.Errorf("%w: %v", ErrSnapshotHeader, err)
+	}
+	if header.Version < 1 || header.Version > SnapshotVersion {
+		return fmt.Errorf("%w: %d", ErrSnapshotVersion, header.Version)
+	}
+	if header.Kind != kind {
+		return fmt.Errorf("%w: %s into %s", ErrSnapshotKind, header.Kind, kind)
+	}
+
+	switch header.Format {
+	case SnapshotGob:
+		return gob.NewDecoder(br).Decode(v)
+	case SnapshotJSON:
+		dec := json.NewDecoder(br)
+		dec.UseNumber()
+		return dec.Decode(v)
+	}
+	return fmt.Errorf("%w: unknown format %q", ErrSnapshotHeader, header.Format)
+}
+
+// readMapSnapshot reads the snapshot of a map, JSON numbers are converted
+// to int or float64 and nested objects to Map.
+func readMapSnapshot(r io.Reader) (Map, error) {
+	var out Map
+	if err := readSnapshot(r, "map", &out); err != nil {
+		return nil, err
+	}
+	if out == nil {
+		out = Map{}
+	}
+	return fromJSONValue(out).(Map), nil
+}
+
+// readListSnapshot reads the snapshot of a list of T, JSON numbers held by
+// a list of interfaces are converted like in readMapSnapshot.
+func readListSnapshot[T any](r io.Reader) ([]T, error) {
+	out := []T{}
+	if err := readSnapshot(r, listKind[T](), &out); err != nil {
+		return nil, err
+	}
+	if out == nil {
+		out = []T{}
+	}
+	for i, v := range out {
+		if e, ok := fromJSONValue(v).(T); ok {
+			out[i] = e
+		}
+	}
+	return out, nil
+}
+
+func fromJSONValue(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if n, err := strconv.Atoi(string(t)); err == nil {
+			return n
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]any:
+		return fromJSONValue(Map(t))
+	case Map:
+		for k, e := range t {
+			t[k] = fromJSONValue(e)
+		}
+		return t
+	case []any:
+		for i, e := range t {
+			t[i] = fromJSONValue(e)
+		}
+		return t
+	}
+	return v
+}
+
+// listKind is the kind of the snapshots of a list of T.
+func listKind[T any]() string {
+	var zero T
+	return fmt.Sprintf("list:%T", zero)
+}
+
+// dictKind is the kind of the snapshots of a Dict of K and V.
+func dictKind[K comparable, V any]() string {
+	var (
+		k K
+		v V
+	)
+	return fmt.Sprintf("dict:%T:%T", k, v)
+}
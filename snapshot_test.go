@@ -0,0 +1,176 @@
+package types
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncMap_Snapshot(t *testing.T) {
+	values := Map{
+		"name": "app",
+		"n":    42,
+		"f":    1.5,
+		"ok":   true,
+		"db":   Map{"port": 5432, "tags": []any{"a", 1}},
+	}
+
+	for _, format := range []SnapshotFormat{SnapshotJSON, SnapshotGob} {
+		for name, newMap := range map[string]func() TSafeMap{
+			"SyncMap":        SyncMap,
+			"SyncMapSharded": func() TSafeMap { return SyncMapSharded(4) },
+			"SyncCache":      func() TSafeMap { return SyncCache(WithCleanupInterval(0)) },
+		} {
+			m := newMap()
+			for k, v := range values {
+				m.Set(k, v)
+			}
+
+			b := bytes.NewBuffer([]byte{})
+			assert.NoError(t, m.Snapshot(b, format), name)
+			assert.True(t, strings.HasPrefix(b.String(), `types-snapshot {"version":1,"format":"`+string(format)+`","kind":"map"}`+"\n"), name)
+
+			restored := newMap()
+			restored.Set("old", 1)
+			s := restored.Watch(nil)
+			assert.NoError(t, restored.Restore(b), name)
+			assert.Equal(t, values, restored.Map(), name)
+			assert.Equal(t, EventReset, (<-s.C).Type, name)
+			assert.Equal(t, EventAdd, (<-s.C).Type, name)
+		}
+	}
+}
+
+func TestSyncMap_SnapshotGobTypes(t *testing.T) {
+	day := time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC)
+	values := Map{
+		"date":     NewDate(day),
+		"datetime": NewDateTime(day.Add(time.Hour)),
+		"month":    NewDateYearMonth(day),
+		"null":     NullDate{},
+		"valid":    NewNullDate(day),
+		"at":       NewNullDateTime(day),
+		"period":   NewNullDateYearMonth(day),
+		"point":    Point{Lat: 1.5, Lng: 2.5},
+		"path":     Points{{Lat: 1, Lng: 2}},
+		"line":     Polyline("_p~iF~ps|U"),
+	}
+
+	m := SyncMap()
+	for k, v := range values {
+		m.Set(k, v)
+	}
+
+	b := bytes.NewBuffer([]byte{})
+	assert.NoError(t, m.Snapshot(b, SnapshotGob))
+
+	restored := SyncMap()
+	assert.NoError(t, restored.Restore(b))
+	assert.Equal(t, values, restored.Map())
+}
+
+func TestSyncList_Snapshot(t *testing.T) {
+	for _, format := range []SnapshotFormat{SnapshotJSON, SnapshotGob} {
+		s := SyncUint64s()
+		s.Add(1, 2, 3)
+
+		b := bytes.NewBuffer([]byte{})
+		assert.NoError(t, s.Snapshot(b, format))
+
+		// Every list of the same element type shares the snapshot format.
+		restored := SyncOf[uint64]()
+		assert.NoError(t, restored.Restore(bytes.NewReader(b.Bytes())))
+		assert.Equal(t, List[uint64]{1, 2, 3}, restored.List())
+
+		s2 := SyncUint64s()
+		assert.NoError(t, s2.Restore(bytes.NewReader(b.Bytes())))
+		assert.Equal(t, Uint64s{1, 2, 3}, s2.Uint64s())
+
+		err := SyncStrings().Restore(bytes.NewReader(b.Bytes()))
+		assert.ErrorIs(t, err, ErrSnapshotKind)
+	}
+}
+
+func TestSyncList_SnapshotAny(t *testing.T) {
+	s := SyncOf[any]()
+	s.Add(1, 1.5, "a", true)
+
+	b := bytes.NewBuffer([]byte{})
+	assert.NoError(t, s.Snapshot(b, SnapshotJSON))
+
+	restored := SyncOf[any]()
+	assert.NoError(t, restored.Restore(b))
+	assert.Equal(t, List[any]{1, 1.5, "a", true}, restored.List())
+}
+
+func TestSyncOrderedMap_Snapshot(t *testing.T) {
+	for _, format := range []SnapshotFormat{SnapshotJSON, SnapshotGob} {
+		nested := NewOrderedMap()
+		nested.Set("port", 5432)
+
+		m := SyncOrderedMap()
+		m.Set("b", "x")
+		m.Set("a", "y")
+		m.Set("n", 42)
+		m.Set("f", 1.5)
+		m.Set("db", nested)
+		m.Set("list", []any{1, 2.5})
+
+		b := bytes.NewBuffer([]byte{})
+		assert.NoError(t, m.Snapshot(b, format))
+
+		restored := SyncOrderedMap()
+		assert.NoError(t, restored.Restore(b))
+		assert.Equal(t, []string{"b", "a", "n", "f", "db", "list"}, restored.Keys(), format)
+		values := restored.Values()
+		assert.Equal(t, []any{"x", "y", 42, 1.5}, values[:4], format)
+		assert.Equal(t, []string{"port"}, values[4].(*OrderedMap).Keys(), format)
+		assert.Equal(t, []any{5432}, values[4].(*OrderedMap).Values(), format)
+		assert.Equal(t, []any{1, 2.5}, values[5], format)
+	}
+}
+
+func TestSnapshot_Errors(t *testing.T) {
+	m := SyncMap()
+	assert.Error(t, m.Snapshot(bytes.NewBuffer([]byte{}), "xml"))
+
+	assert.ErrorIs(t, m.Restore(strings.NewReader(`{"a":1}`)), ErrSnapshotHeader)
+	assert.ErrorIs(t, m.Restore(strings.NewReader("types-snapshot {\"version\":2,\"format\":\"json\",\"kind\":\"map\"}\n{}")), ErrSnapshotVersion)
+	assert.ErrorIs(t, m.Restore(strings.NewReader("types-snapshot {\"version\":1,\"format\":\"json\",\"kind\":\"ordered-map\"}\n{}")), ErrSnapshotKind)
+	assert.Error(t, m.Restore(strings.NewReader("types-snapshot {\"version\":1,\"format\":\"json\",\"kind\":\"map\"}\n{")))
+}
+
+func TestSnapshotFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.snap")
+
+	m := SyncMap()
+	m.Set("a", 1)
+	assert.NoError(t, SnapshotFile(path, m, SnapshotGob))
+
+	m.Set("a", 2)
+	assert.NoError(t, SnapshotFile(path, m, SnapshotJSON))
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	restored := SyncMap()
+	assert.NoError(t, RestoreFile(path, restored))
+	assert.Equal(t, Map{"a": 2}, restored.Map())
+
+	// A failed snapshot leaves the previous file untouched.
+	m.Set("ch", make(chan int))
+	assert.Error(t, SnapshotFile(path, m, SnapshotJSON))
+	entries, _ = os.ReadDir(dir)
+	assert.Len(t, entries, 1)
+	assert.NoError(t, RestoreFile(path, restored))
+	assert.Equal(t, Map{"a": 2}, restored.Map())
+
+	assert.Error(t, RestoreFile(filepath.Join(dir, "missing"), restored))
+}
@@ -1,11 +1,32 @@
 package types
 
-import "sync"
+import "io"
 
 type TSafeInt64s interface {
 	// Reset the slice.
 	Reset()
 
+	// Add new elements to the slice.
+	Add(...int64)
+
+	// Set the element "i" and say if it exists.
+	Set(int, int64) bool
+
+	// RemoveAt remove the element "i" and return it.
+	RemoveAt(int) (int64, bool)
+
+	// Remove every occurrence of "values" and return the number of removed elements.
+	Remove(...int64) int
+
+	// Pop remove the last element and return it.
+	Pop() (int64, bool)
+
+	// Shift remove the first element and return it.
+	Shift() (int64, bool)
+
+	// Update run "f" on the slice under a single write lock.
+	Update(func(*Int64s))
+
 	// Contains say if "s" contains "values".
 	Contains(...int64) bool
 
@@ -53,132 +74,23 @@ type TSafeInt64s interface {
 
 	// S convert s into Int64s
 	Int64s() Int64s
-}
-
-func SyncInt64s() TSafeInt64s {
-	return &tsafeInt64s{&sync.RWMutex{}, Int64s{}}
-}
 
-type tsafeInt64s struct {
-	mu     *sync.RWMutex
-	values Int64s
-}
-
-func (s *tsafeInt64s) Reset() {
-	s.mu.Lock()
-	s.values.Reset()
-	s.mu.Unlock()
-}
-
-func (s *tsafeInt64s) Contains(values ...int64) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Contains(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) ContainsOneOf(values ...int64) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.ContainsOneOf(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Copy() TSafeInt64s {
-	s2 := &tsafeInt64s{mu: &sync.RWMutex{}}
-	s.mu.RLock()
-	s2.values = s.values.Copy()
-	s.mu.RUnlock()
-	return s2
-}
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
 
-func (s *tsafeInt64s) Diff(s2 Int64s) (out Int64s) {
-	s.mu.RLock()
-	out = s.values.Diff(s2)
-	s.mu.RUnlock()
-	return
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
 }
 
-func (s *tsafeInt64s) Empty() (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Empty()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Equal(s2 Int64s) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Equal(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Find(matcher func(v int64) bool) (v int64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Find(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) FindAll(matcher func(v int64) bool) (v Int64s) {
-	s.mu.RLock()
-	v = s.values.FindAll(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) First() (v int64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.First()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Get(i int) (v int64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Get(i)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Intersect(s2 Int64s) (v Int64s) {
-	s.mu.RLock()
-	v = s.values.Intersect(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Last() (v int64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Last()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Len() (v int) {
-	s.mu.RLock()
-	v = s.values.Len()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInt64s) Take(n int) (v Int64s) {
-	s.mu.RLock()
-	v = s.values.Take(n)
-	s.mu.RUnlock()
-	return
+// SyncInt64s return a new thread-safe Int64s.
+func SyncInt64s() TSafeInt64s {
+	return tsafeInt64s{newTSafeNumberList(Int64s{})}
 }
 
-func (s *tsafeInt64s) S() (out []any) {
-	s.mu.RLock()
-	out = s.values.S()
-	s.mu.RUnlock()
-	return
+type tsafeInt64s struct {
+	*tsafeNumberList[int64]
 }
 
-func (s *tsafeInt64s) Int64s() (v Int64s) {
-	s.mu.RLock()
-	v = s.values.Copy()
-	s.mu.RUnlock()
-	return
+func (s tsafeInt64s) Copy() TSafeInt64s {
+	return tsafeInt64s{newTSafeNumberList(s.NumberList())}
 }
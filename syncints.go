@@ -1,11 +1,32 @@
 package types
 
-import "sync"
+import "io"
 
 type TSafeInts interface {
 	// Reset the slice.
 	Reset()
 
+	// Add new elements to the slice.
+	Add(...int)
+
+	// Set the element "i" and say if it exists.
+	Set(int, int) bool
+
+	// RemoveAt remove the element "i" and return it.
+	RemoveAt(int) (int, bool)
+
+	// Remove every occurrence of "values" and return the number of removed elements.
+	Remove(...int) int
+
+	// Pop remove the last element and return it.
+	Pop() (int, bool)
+
+	// Shift remove the first element and return it.
+	Shift() (int, bool)
+
+	// Update run "f" on the slice under a single write lock.
+	Update(func(*Ints))
+
 	// Contains say if "s" contains "values".
 	Contains(...int) bool
 
@@ -53,132 +74,23 @@ type TSafeInts interface {
 
 	// Ints convert s into Ints
 	Ints() Ints
-}
-
-func SyncInts() TSafeInts {
-	return &tsafeInts{&sync.RWMutex{}, Ints{}}
-}
 
-type tsafeInts struct {
-	mu     *sync.RWMutex
-	values Ints
-}
-
-func (s *tsafeInts) Reset() {
-	s.mu.Lock()
-	s.values.Reset()
-	s.mu.Unlock()
-}
-
-func (s *tsafeInts) Contains(values ...int) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Contains(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) ContainsOneOf(values ...int) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.ContainsOneOf(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Copy() TSafeInts {
-	s2 := &tsafeInts{mu: &sync.RWMutex{}}
-	s.mu.RLock()
-	s2.values = s.values.Copy()
-	s.mu.RUnlock()
-	return s2
-}
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
 
-func (s *tsafeInts) Diff(s2 Ints) (out Ints) {
-	s.mu.RLock()
-	out = s.values.Diff(s2)
-	s.mu.RUnlock()
-	return
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
 }
 
-func (s *tsafeInts) Empty() (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Empty()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Equal(s2 Ints) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Equal(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Find(matcher func(v int) bool) (v int, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Find(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) FindAll(matcher func(v int) bool) (v Ints) {
-	s.mu.RLock()
-	v = s.values.FindAll(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) First() (v int, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.First()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Get(i int) (v int, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Get(i)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Intersect(s2 Ints) (v Ints) {
-	s.mu.RLock()
-	v = s.values.Intersect(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Last() (v int, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Last()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Len() (v int) {
-	s.mu.RLock()
-	v = s.values.Len()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeInts) Take(n int) (v Ints) {
-	s.mu.RLock()
-	v = s.values.Take(n)
-	s.mu.RUnlock()
-	return
+// SyncInts return a new thread-safe Ints.
+func SyncInts() TSafeInts {
+	return tsafeInts{newTSafeNumberList(Ints{})}
 }
 
-func (s *tsafeInts) S() (out []any) {
-	s.mu.RLock()
-	out = s.values.S()
-	s.mu.RUnlock()
-	return
+type tsafeInts struct {
+	*tsafeNumberList[int]
 }
 
-func (s *tsafeInts) Ints() (v Ints) {
-	s.mu.RLock()
-	v = s.values.Copy()
-	s.mu.RUnlock()
-	return
+func (s tsafeInts) Copy() TSafeInts {
+	return tsafeInts{newTSafeNumberList(s.NumberList())}
 }
@@ -0,0 +1,314 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"io"
+	"sync"
+)
+
+// TSafeList abstract the implementation of SyncOf.
+type TSafeList[T comparable] interface {
+	// Reset the slice.
+	Reset()
+
+	// Add new elements to the slice.
+	Add(...T)
+
+	// Set the element "i" and say if it exists.
+	Set(int, T) bool
+
+	// RemoveAt remove the element "i" and return it.
+	RemoveAt(int) (T, bool)
+
+	// Remove every occurrence of "values" and return the number of removed elements.
+	Remove(...T) int
+
+	// Pop remove the last element and return it.
+	Pop() (T, bool)
+
+	// Shift remove the first element and return it.
+	Shift() (T, bool)
+
+	// Update run "f" on the slice under a single write lock.
+	Update(func(*List[T]))
+
+	// Contains say if "s" contains "values".
+	Contains(...T) bool
+
+	// ContainsOneOf says if "s" contains one of the "values".
+	ContainsOneOf(...T) bool
+
+	// Copy create a new copy of the slice.
+	Copy() TSafeList[T]
+
+	// Diff returns the difference between "s" and "s2".
+	Diff(List[T]) List[T]
+
+	// Empty says if the slice is empty.
+	Empty() bool
+
+	// Equal says if "s" and "s2" are equal.
+	Equal(List[T]) bool
+
+	// Excludes elements from s2.
+	Excludes(List[T]) List[T]
+
+	// Filter elements matching the pattern.
+	Filter(func(v T) bool) List[T]
+
+	// Find the first element matching the pattern.
+	Find(func(v T) bool) (T, bool)
+
+	// FindAll elements matching the pattern.
+	FindAll(func(v T) bool) List[T]
+
+	// First return the value of the first element.
+	First() (T, bool)
+
+	// Get the element "i" and say if it has been found.
+	Get(int) (T, bool)
+
+	// Intersect return the intersection between "s" and "s2".
+	Intersect(List[T]) List[T]
+
+	// Last return the value of the last element.
+	Last() (T, bool)
+
+	// Len returns the size of the slice.
+	Len() int
+
+	// LenIf return the size of the slice if the filter is valid.
+	LenIf(func(v T) bool) int
+
+	// Take n element and return a new slice.
+	Take(int) List[T]
+
+	// S convert s into []any
+	S() []any
+
+	// List convert s into List
+	List() List[T]
+
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
+
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
+}
+
+// SyncOf return a new thread-safe List of T.
+func SyncOf[T comparable]() TSafeList[T] {
+	return newTSafeList(List[T]{})
+}
+
+func newTSafeList[T comparable](values List[T]) *tsafeList[T] {
+	return &tsafeList[T]{&sync.RWMutex{}, values}
+}
+
+type tsafeList[T comparable] struct {
+	mu     *sync.RWMutex
+	values List[T]
+}
+
+func (s *tsafeList[T]) Reset() {
+	s.mu.Lock()
+	s.values.Reset()
+	s.mu.Unlock()
+}
+
+func (s *tsafeList[T]) Add(values ...T) {
+	s.mu.Lock()
+	s.values.Add(values...)
+	s.mu.Unlock()
+}
+
+func (s *tsafeList[T]) Set(i int, v T) (ok bool) {
+	s.mu.Lock()
+	ok = s.values.Set(i, v)
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeList[T]) RemoveAt(i int) (v T, ok bool) {
+	s.mu.Lock()
+	v, ok = s.values.RemoveAt(i)
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeList[T]) Remove(values ...T) (n int) {
+	s.mu.Lock()
+	n = s.values.Remove(values...)
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeList[T]) Pop() (v T, ok bool) {
+	s.mu.Lock()
+	v, ok = s.values.Pop()
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeList[T]) Shift() (v T, ok bool) {
+	s.mu.Lock()
+	v, ok = s.values.Shift()
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeList[T]) Update(f func(*List[T])) {
+	s.mu.Lock()
+	f(&s.values)
+	s.mu.Unlock()
+}
+
+func (s *tsafeList[T]) Contains(values ...T) (ok bool) {
+	s.mu.RLock()
+	ok = s.values.Contains(values...)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) ContainsOneOf(values ...T) (ok bool) {
+	s.mu.RLock()
+	ok = s.values.ContainsOneOf(values...)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Copy() TSafeList[T] {
+	return newTSafeList(s.List())
+}
+
+func (s *tsafeList[T]) Diff(s2 List[T]) (out List[T]) {
+	s.mu.RLock()
+	out = s.values.Diff(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Empty() (ok bool) {
+	s.mu.RLock()
+	ok = s.values.Empty()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Equal(s2 List[T]) (ok bool) {
+	s.mu.RLock()
+	ok = s.values.Equal(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Excludes(s2 List[T]) (out List[T]) {
+	s.mu.RLock()
+	out = s.values.Excludes(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Filter(matcher func(v T) bool) (out List[T]) {
+	s.mu.RLock()
+	out = s.values.Filter(matcher)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Find(matcher func(v T) bool) (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.Find(matcher)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) FindAll(matcher func(v T) bool) (out List[T]) {
+	s.mu.RLock()
+	out = s.values.FindAll(matcher)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) First() (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.First()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Get(i int) (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.Get(i)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Intersect(s2 List[T]) (out List[T]) {
+	s.mu.RLock()
+	out = s.values.Intersect(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Last() (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.Last()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Len() (n int) {
+	s.mu.RLock()
+	n = s.values.Len()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) LenIf(f func(v T) bool) (n int) {
+	s.mu.RLock()
+	n = s.values.LenIf(f)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Take(n int) (out List[T]) {
+	s.mu.RLock()
+	out = s.values.Take(n).Copy()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) S() (out []any) {
+	s.mu.RLock()
+	out = s.values.S()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) List() (out List[T]) {
+	s.mu.RLock()
+	out = s.values.Copy()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeList[T]) Snapshot(w io.Writer, format SnapshotFormat) error {
+	return writeSnapshot(w, listKind[T](), format, s.List())
+}
+
+func (s *tsafeList[T]) Restore(r io.Reader) error {
+	values, err := readListSnapshot[T](r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.values = values
+	s.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncOf(t *testing.T) {
+	s := SyncOf[float64]()
+	assert.True(t, s.Empty())
+
+	s2 := s.Copy()
+	assert.Equal(t, List[float64]{}, s2.List())
+	assert.Equal(t, List[float64]{1, 2}, s.Diff(List[float64]{1, 2}))
+}
+
+func TestSyncNumbersOf(t *testing.T) {
+	s := SyncNumbersOf[uint]()
+	assert.Equal(t, uint(0), s.Sum())
+	assert.Equal(t, NumberList[uint]{}, s.NumberList())
+	assert.Equal(t, Uint64s{}, s.Uint64s())
+}
+
+func TestSyncInts_Copy(t *testing.T) {
+	s := SyncInts()
+	s2 := s.Copy()
+
+	assert.Equal(t, Ints{}, s2.Ints())
+	assert.NotSame(t, s, s2)
+}
+
+func TestSyncUint64s_Add(t *testing.T) {
+	s := SyncUint64s()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(v uint64) {
+			defer wg.Done()
+			s.Add(v)
+		}(uint64(i))
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, s.Len())
+	assert.Equal(t, uint64(1225), s.Uint64s().Sum())
+}
+
+func TestSyncInts_Update(t *testing.T) {
+	s := SyncInts()
+	s.Add(1, 2, 3)
+	s.Update(func(v *Ints) {
+		v.Remove(2)
+		v.Add(4)
+	})
+
+	assert.Equal(t, Ints{1, 3, 4}, s.Ints())
+
+	v, ok := s.Shift()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+}
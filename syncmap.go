@@ -5,13 +5,24 @@
 
 package types
 
-import "sync"
+import (
+	"io"
+	"reflect"
+	"sync"
+)
 
 // TSafeMap abstract the implementation of SyncMap.
 type TSafeMap interface {
 	// Add a new entry if the given key is not filled.
 	Add(string, any)
 
+	// CompareAndSwap replaces the value of the key if it is deeply equal to
+	// "old" and say if it has been replaced.
+	CompareAndSwap(k string, old, new any) bool
+
+	// Delete the key and say if it existed.
+	Delete(string) bool
+
 	// Find the first element matching the pattern.
 	Find(Matcher) (string, any, bool)
 
@@ -21,14 +32,45 @@ type TSafeMap interface {
 	// Get an element from the key.
 	Get(string) (any, bool)
 
+	// GetOrSet returns the value of the key if it exists, otherwise it sets
+	// and returns "v". The boolean says if the value was loaded.
+	GetOrSet(k string, v any) (any, bool)
+
+	// Keys return the list of keys.
+	Keys() []string
+
+	// Len returns the size of the map.
+	Len() int
+
+	// LoadAndDelete deletes the key and returns its previous value.
+	LoadAndDelete(string) (any, bool)
+
 	// Map convert TSafeMap to Map.
 	Map() Map
 
+	// Range calls "f" for each entry of a snapshot until it returns false,
+	// "f" may change the map.
+	Range(f func(k string, v any) bool)
+
 	// Set a new entry or change an entry for the given key "k".
 	Set(string, any)
 
 	// Reset the values.
 	Reset()
+
+	// Update sets the key to the value returned by "f", which is called with
+	// the current value while the map is locked. The new value is returned.
+	Update(k string, f func(old any, ok bool) any) any
+
+	// Watch subscribes to the changes of the keys matched by "matcher", all
+	// the keys are watched when it is nil. See KeyPrefix.
+	Watch(matcher Matcher, opts ...WatchOption) *Subscription
+
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
+
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
 }
 
 // SyncMap return a new ThreadSafeMap.
@@ -36,6 +78,7 @@ func SyncMap() TSafeMap {
 	return &tsafeMap{
 		&sync.RWMutex{},
 		make(Map, 0),
+		newWatchHub(),
 	}
 }
 
@@ -43,16 +86,60 @@ func SyncMap() TSafeMap {
 type tsafeMap struct {
 	mu     *sync.RWMutex
 	values Map
+	hub    *watchHub
+}
+
+// set the value of the key and notify the watchers, the lock must be held.
+func (m *tsafeMap) set(k string, v any) {
+	old, ok := m.values[k]
+	m.values[k] = v
+	m.hub.changed(k, old, ok, v)
+}
+
+// replace the values and notify the watchers, the lock must be held.
+func (m *tsafeMap) replace(values Map) {
+	old := m.values
+	m.values = values
+	m.hub.reset(old)
+	for _, k := range sortedKeys(values) {
+		m.hub.changed(k, nil, false, values[k])
+	}
+}
+
+// delete the key and notify the watchers, the lock must be held.
+func (m *tsafeMap) delete(k string) (old any, ok bool) {
+	if old, ok = m.values[k]; ok {
+		delete(m.values, k)
+		m.hub.deleted(k, old)
+	}
+	return
 }
 
 func (m *tsafeMap) Add(k string, v any) {
 	m.mu.Lock()
 	if _, ok := m.values[k]; !ok {
-		m.values[k] = v
+		m.set(k, v)
 	}
 	m.mu.Unlock()
 }
 
+func (m *tsafeMap) CompareAndSwap(k string, old, new any) (ok bool) {
+	m.mu.Lock()
+	if v, exists := m.values[k]; exists && reflect.DeepEqual(v, old) {
+		m.set(k, new)
+		ok = true
+	}
+	m.mu.Unlock()
+	return
+}
+
+func (m *tsafeMap) Delete(k string) (ok bool) {
+	m.mu.Lock()
+	_, ok = m.delete(k)
+	m.mu.Unlock()
+	return
+}
+
 func (m *tsafeMap) Find(matcher Matcher) (k string, v any, ok bool) {
 	m.mu.RLock()
 	k, v, ok = m.values.Find(matcher)
@@ -74,6 +161,37 @@ func (m *tsafeMap) Get(k string) (v any, ok bool) {
 	return
 }
 
+func (m *tsafeMap) GetOrSet(k string, v any) (actual any, loaded bool) {
+	m.mu.Lock()
+	if actual, loaded = m.values[k]; !loaded {
+		m.set(k, v)
+		actual = v
+	}
+	m.mu.Unlock()
+	return
+}
+
+func (m *tsafeMap) Keys() (out []string) {
+	m.mu.RLock()
+	out = m.values.Keys()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeMap) Len() (n int) {
+	m.mu.RLock()
+	n = len(m.values)
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeMap) LoadAndDelete(k string) (v any, ok bool) {
+	m.mu.Lock()
+	v, ok = m.delete(k)
+	m.mu.Unlock()
+	return
+}
+
 func (m *tsafeMap) Map() (out Map) {
 	m.mu.RLock()
 	out = m.values.Copy()
@@ -81,14 +199,54 @@ func (m *tsafeMap) Map() (out Map) {
 	return
 }
 
+func (m *tsafeMap) Range(f func(k string, v any) bool) {
+	for k, v := range m.Map() {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
 func (m *tsafeMap) Set(k string, v any) {
 	m.mu.Lock()
-	m.values[k] = v
+	m.set(k, v)
 	m.mu.Unlock()
 }
 
 func (m *tsafeMap) Reset() {
 	m.mu.Lock()
+	old := m.values
 	m.values.Reset()
+	m.hub.reset(old)
+	m.mu.Unlock()
+}
+
+func (m *tsafeMap) Update(k string, f func(old any, ok bool) any) (v any) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	old, ok := m.values[k]
+	v = f(old, ok)
+	m.set(k, v)
+	return
+}
+
+func (m *tsafeMap) Watch(matcher Matcher, opts ...WatchOption) *Subscription {
+	return m.hub.watch(matcher, opts)
+}
+
+func (m *tsafeMap) Snapshot(w io.Writer, format SnapshotFormat) error {
+	return writeSnapshot(w, "map", format, m.Map())
+}
+
+func (m *tsafeMap) Restore(r io.Reader) error {
+	values, err := readMapSnapshot(r)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.replace(values)
 	m.mu.Unlock()
+	return nil
 }
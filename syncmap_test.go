@@ -0,0 +1,103 @@
+package types
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncMap(t *testing.T) {
+	m := SyncMap()
+	m.Set("a", 1)
+	m.Add("a", 2)
+	m.Add("b", 2)
+	assert.Equal(t, 2, m.Len())
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, []string{"a", "b"}, keys)
+
+	assert.True(t, m.Delete("a"))
+	assert.False(t, m.Delete("a"))
+
+	v, ok := m.LoadAndDelete("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	_, ok = m.LoadAndDelete("b")
+	assert.False(t, ok)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSyncMap_GetOrSet(t *testing.T) {
+	m := SyncMap()
+	v, loaded := m.GetOrSet("a", 1)
+	assert.False(t, loaded)
+	assert.Equal(t, 1, v)
+
+	v, loaded = m.GetOrSet("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, v)
+}
+
+func TestSyncMap_CompareAndSwap(t *testing.T) {
+	m := SyncMap()
+	assert.False(t, m.CompareAndSwap("a", nil, 1))
+
+	m.Set("a", Strings{"x"})
+	assert.False(t, m.CompareAndSwap("a", Strings{"y"}, 1))
+	assert.True(t, m.CompareAndSwap("a", Strings{"x"}, 1))
+
+	v, _ := m.Get("a")
+	assert.Equal(t, 1, v)
+}
+
+func TestSyncMap_Range(t *testing.T) {
+	m := SyncMap()
+	for _, k := range []string{"a", "b", "c"} {
+		m.Set(k, k)
+	}
+
+	n := 0
+	m.Range(func(k string, v any) bool {
+		assert.Equal(t, k, v)
+		m.Delete(k)
+		n++
+		return n < 2
+	})
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestSyncMap_Concurrent(t *testing.T) {
+	m := SyncMap()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Update("counter", func(old any, ok bool) any {
+					if !ok {
+						return 1
+					}
+					return old.(int) + 1
+				})
+
+				for {
+					old, _ := m.GetOrSet("cas", 0)
+					if m.CompareAndSwap("cas", old, old.(int)+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	v, _ := m.Get("counter")
+	assert.Equal(t, 5000, v)
+	v, _ = m.Get("cas")
+	assert.Equal(t, 5000, v)
+}
@@ -0,0 +1,214 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"io"
+	"sync"
+)
+
+// DefaultShards is the number of shards used by SyncMapSharded when n < 1.
+const DefaultShards = 32
+
+// SyncMapSharded return a new ThreadSafeMap splitting its keys into "n"
+// independently locked shards, so that writes on different keys don't wait
+// for each other. "n" is rounded up to a power of two.
+//
+// Map, FindAll, Keys, Len, Range and Reset lock every shard and see a
+// consistent snapshot. Watch events keep the order of the writes of each key.
+func SyncMapSharded(n int) TSafeMap {
+	if n < 1 {
+		n = DefaultShards
+	}
+
+	size := 1
+	for size < n {
+		size <<= 1
+	}
+
+	m := &tsafeShardedMap{
+		shards: make([]*tsafeMap, size),
+		mask:   uint32(size - 1),
+		hub:    newWatchHub(),
+	}
+	for i := range m.shards {
+		m.shards[i] = &tsafeMap{&sync.RWMutex{}, make(Map, 0), m.hub}
+	}
+	return m
+}
+
+type tsafeShardedMap struct {
+	shards []*tsafeMap
+	mask   uint32
+	hub    *watchHub
+}
+
+// shard returns the shard of the key "k", keys are hashed with FNV-1a.
+func (m *tsafeShardedMap) shard(k string) *tsafeMap {
+	h := uint32(2166136261)
+	for i := 0; i < len(k); i++ {
+		h ^= uint32(k[i])
+		h *= 16777619
+	}
+	return m.shards[h&m.mask]
+}
+
+func (m *tsafeShardedMap) rlockAll() {
+	for _, s := range m.shards {
+		s.mu.RLock()
+	}
+}
+
+func (m *tsafeShardedMap) runlockAll() {
+	for _, s := range m.shards {
+		s.mu.RUnlock()
+	}
+}
+
+func (m *tsafeShardedMap) Add(k string, v any) {
+	m.shard(k).Add(k, v)
+}
+
+func (m *tsafeShardedMap) CompareAndSwap(k string, old, new any) bool {
+	return m.shard(k).CompareAndSwap(k, old, new)
+}
+
+func (m *tsafeShardedMap) Delete(k string) bool {
+	return m.shard(k).Delete(k)
+}
+
+func (m *tsafeShardedMap) Find(matcher Matcher) (k string, v any, ok bool) {
+	m.rlockAll()
+	defer m.runlockAll()
+
+	for _, s := range m.shards {
+		if k, v, ok = s.values.Find(matcher); ok {
+			return
+		}
+	}
+	return
+}
+
+func (m *tsafeShardedMap) FindAll(matcher Matcher) (out Map) {
+	out = Map{}
+	m.rlockAll()
+	for _, s := range m.shards {
+		out.Merge(s.values.FindAll(matcher))
+	}
+	m.runlockAll()
+	return
+}
+
+func (m *tsafeShardedMap) Get(k string) (any, bool) {
+	return m.shard(k).Get(k)
+}
+
+func (m *tsafeShardedMap) GetOrSet(k string, v any) (any, bool) {
+	return m.shard(k).GetOrSet(k, v)
+}
+
+func (m *tsafeShardedMap) Keys() (out []string) {
+	m.rlockAll()
+	for _, s := range m.shards {
+		out = append(out, s.values.Keys()...)
+	}
+	m.runlockAll()
+	return
+}
+
+func (m *tsafeShardedMap) Len() (n int) {
+	m.rlockAll()
+	for _, s := range m.shards {
+		n += len(s.values)
+	}
+	m.runlockAll()
+	return
+}
+
+func (m *tsafeShardedMap) LoadAndDelete(k string) (any, bool) {
+	return m.shard(k).LoadAndDelete(k)
+}
+
+func (m *tsafeShardedMap) Map() (out Map) {
+	out = Map{}
+	m.rlockAll()
+	for _, s := range m.shards {
+		out.Merge(s.values)
+	}
+	m.runlockAll()
+	return
+}
+
+func (m *tsafeShardedMap) Range(f func(k string, v any) bool) {
+	for k, v := range m.Map() {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+func (m *tsafeShardedMap) Set(k string, v any) {
+	m.shard(k).Set(k, v)
+}
+
+func (m *tsafeShardedMap) Reset() {
+	for _, s := range m.shards {
+		s.mu.Lock()
+	}
+
+	old := Map{}
+	for _, s := range m.shards {
+		old.Merge(s.values)
+		s.values.Reset()
+	}
+	m.hub.reset(old)
+
+	for _, s := range m.shards {
+		s.mu.Unlock()
+	}
+}
+
+func (m *tsafeShardedMap) Update(k string, f func(old any, ok bool) any) any {
+	return m.shard(k).Update(k, f)
+}
+
+func (m *tsafeShardedMap) Watch(matcher Matcher, opts ...WatchOption) *Subscription {
+	return m.hub.watch(matcher, opts)
+}
+
+func (m *tsafeShardedMap) Snapshot(w io.Writer, format SnapshotFormat) error {
+	return writeSnapshot(w, "map", format, m.Map())
+}
+
+func (m *tsafeShardedMap) Restore(r io.Reader) error {
+	values, err := readMapSnapshot(r)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range m.shards {
+		s.mu.Lock()
+	}
+
+	old := Map{}
+	for _, s := range m.shards {
+		old.Merge(s.values)
+		s.values.Reset()
+	}
+	for k, v := range values {
+		m.shard(k).values[k] = v
+	}
+
+	m.hub.reset(old)
+	for _, k := range sortedKeys(values) {
+		m.hub.changed(k, nil, false, values[k])
+	}
+
+	for _, s := range m.shards {
+		s.mu.Unlock()
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncMapSharded(t *testing.T) {
+	m := SyncMapSharded(5)
+	assert.Len(t, m.(*tsafeShardedMap).shards, 8)
+	assert.Len(t, SyncMapSharded(0).(*tsafeShardedMap).shards, DefaultShards)
+
+	for i := 0; i < 100; i++ {
+		m.Set(strconv.Itoa(i), i)
+	}
+	m.Add("1", -1)
+	assert.Equal(t, 100, m.Len())
+	assert.Len(t, m.Map(), 100)
+
+	v, ok := m.Get("1")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, "0", keys[0])
+	assert.Len(t, keys, 100)
+
+	even := m.FindAll(func(_ string, v any) bool { return v.(int)%2 == 0 })
+	assert.Len(t, even, 50)
+
+	k, v, ok := m.Find(func(_ string, v any) bool { return v.(int) == 42 })
+	assert.True(t, ok)
+	assert.Equal(t, "42", k)
+	assert.Equal(t, 42, v)
+
+	assert.True(t, m.Delete("42"))
+	v, ok = m.LoadAndDelete("43")
+	assert.True(t, ok)
+	assert.Equal(t, 43, v)
+
+	v, loaded := m.GetOrSet("42", 0)
+	assert.False(t, loaded)
+	assert.Equal(t, 0, v)
+	assert.True(t, m.CompareAndSwap("42", 0, 42))
+
+	n := 0
+	m.Range(func(string, any) bool {
+		n++
+		return true
+	})
+	assert.Equal(t, 99, n)
+
+	m.Reset()
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestSyncMapSharded_Concurrent(t *testing.T) {
+	m := SyncMapSharded(16)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Set(strconv.Itoa(i*100+j), j)
+				m.Update("counter", func(old any, ok bool) any {
+					if !ok {
+						return 1
+					}
+					return old.(int) + 1
+				})
+				if j%10 == 0 {
+					m.Map()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	v, _ := m.Get("counter")
+	assert.Equal(t, 6400, v)
+	assert.Equal(t, 6401, m.Len())
+}
+
+var benchKeys = func() []string {
+	out := make([]string, 1024)
+	for i := range out {
+		out[i] = "key-" + strconv.Itoa(i)
+	}
+	return out
+}()
+
+// benchmarkMap runs a workload with one write for every "reads" reads.
+func benchmarkMap(b *testing.B, reads int, get func(string), set func(string, int)) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			k := benchKeys[i%len(benchKeys)]
+			if i%(reads+1) == 0 {
+				set(k, i)
+			} else {
+				get(k)
+			}
+			i++
+		}
+	})
+}
+
+func benchmarkMaps(b *testing.B, reads int) {
+	b.Run("SyncMap", func(b *testing.B) {
+		m := SyncMap()
+		benchmarkMap(b, reads, func(k string) { m.Get(k) }, func(k string, v int) { m.Set(k, v) })
+	})
+	b.Run("SyncMapSharded", func(b *testing.B) {
+		m := SyncMapSharded(DefaultShards)
+		benchmarkMap(b, reads, func(k string) { m.Get(k) }, func(k string, v int) { m.Set(k, v) })
+	})
+	b.Run("sync.Map", func(b *testing.B) {
+		m := sync.Map{}
+		benchmarkMap(b, reads, func(k string) { m.Load(k) }, func(k string, v int) { m.Store(k, v) })
+	})
+}
+
+func BenchmarkMaps_WriteOnly(b *testing.B) {
+	benchmarkMaps(b, 0)
+}
+
+func BenchmarkMaps_ReadWrite(b *testing.B) {
+	benchmarkMaps(b, 1)
+}
+
+func BenchmarkMaps_ReadMostly(b *testing.B) {
+	benchmarkMaps(b, 9)
+}
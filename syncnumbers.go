@@ -0,0 +1,404 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"io"
+	"sync"
+)
+
+// TSafeNumberList abstract the implementation of SyncNumbersOf.
+type TSafeNumberList[T Number] interface {
+	// Reset the slice.
+	Reset()
+
+	// Add new elements to the slice.
+	Add(...T)
+
+	// Set the element "i" and say if it exists.
+	Set(int, T) bool
+
+	// RemoveAt remove the element "i" and return it.
+	RemoveAt(int) (T, bool)
+
+	// Remove every occurrence of "values" and return the number of removed elements.
+	Remove(...T) int
+
+	// Pop remove the last element and return it.
+	Pop() (T, bool)
+
+	// Shift remove the first element and return it.
+	Shift() (T, bool)
+
+	// Update run "f" on the slice under a single write lock.
+	Update(func(*NumberList[T]))
+
+	// Contains say if "s" contains "values".
+	Contains(...T) bool
+
+	// ContainsOneOf says if "s" contains one of the "values".
+	ContainsOneOf(...T) bool
+
+	// Copy create a new copy of the slice.
+	Copy() TSafeNumberList[T]
+
+	// Diff returns the difference between "s" and "s2".
+	Diff(NumberList[T]) NumberList[T]
+
+	// Empty says if the slice is empty.
+	Empty() bool
+
+	// Equal says if "s" and "s2" are equal.
+	Equal(NumberList[T]) bool
+
+	// Excludes elements from s2.
+	Excludes(NumberList[T]) NumberList[T]
+
+	// Filter elements matching the pattern.
+	Filter(func(v T) bool) NumberList[T]
+
+	// Find the first element matching the pattern.
+	Find(func(v T) bool) (T, bool)
+
+	// FindAll elements matching the pattern.
+	FindAll(func(v T) bool) NumberList[T]
+
+	// First return the value of the first element.
+	First() (T, bool)
+
+	// Get the element "i" and say if it has been found.
+	Get(int) (T, bool)
+
+	// Intersect return the intersection between "s" and "s2".
+	Intersect(NumberList[T]) NumberList[T]
+
+	// Last return the value of the last element.
+	Last() (T, bool)
+
+	// Len returns the size of the slice.
+	Len() int
+
+	// LenIf return the size of the slice if the filter is valid.
+	LenIf(func(v T) bool) int
+
+	// Take n element and return a new slice.
+	Take(int) NumberList[T]
+
+	// S convert s into []any
+	S() []any
+
+	// Mean of the slice.
+	Mean() float64
+
+	// MeanIf the filter is valid of the slice.
+	MeanIf(func(v T) bool) float64
+
+	// Sum of the slice.
+	Sum() T
+
+	// SumIf the filter is valid of the slice.
+	SumIf(func(v T) bool) T
+
+	// NumberList convert s into NumberList
+	NumberList() NumberList[T]
+
+	// Ints convert s into Ints
+	Ints() Ints
+
+	// Int64s convert s into Int64s
+	Int64s() Int64s
+
+	// Uints convert s into Uints
+	Uints() Uints
+
+	// Uint64s convert s into Uint64s
+	Uint64s() Uint64s
+
+	// Floats convert s into Floats
+	Floats() Floats
+
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
+
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
+}
+
+// SyncNumbersOf return a new thread-safe NumberList of T.
+func SyncNumbersOf[T Number]() TSafeNumberList[T] {
+	return newTSafeNumberList(NumberList[T]{})
+}
+
+func newTSafeNumberList[T Number](values NumberList[T]) *tsafeNumberList[T] {
+	return &tsafeNumberList[T]{&sync.RWMutex{}, values}
+}
+
+type tsafeNumberList[T Number] struct {
+	mu     *sync.RWMutex
+	values NumberList[T]
+}
+
+func (s *tsafeNumberList[T]) Reset() {
+	s.mu.Lock()
+	s.values.Reset()
+	s.mu.Unlock()
+}
+
+func (s *tsafeNumberList[T]) Add(values ...T) {
+	s.mu.Lock()
+	s.values.Add(values...)
+	s.mu.Unlock()
+}
+
+func (s *tsafeNumberList[T]) Set(i int, v T) (ok bool) {
+	s.mu.Lock()
+	ok = s.values.Set(i, v)
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) RemoveAt(i int) (v T, ok bool) {
+	s.mu.Lock()
+	v, ok = s.values.RemoveAt(i)
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Remove(values ...T) (n int) {
+	s.mu.Lock()
+	n = s.values.Remove(values...)
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Pop() (v T, ok bool) {
+	s.mu.Lock()
+	v, ok = s.values.Pop()
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Shift() (v T, ok bool) {
+	s.mu.Lock()
+	v, ok = s.values.Shift()
+	s.mu.Unlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Update(f func(*NumberList[T])) {
+	s.mu.Lock()
+	f(&s.values)
+	s.mu.Unlock()
+}
+
+func (s *tsafeNumberList[T]) Contains(values ...T) (ok bool) {
+	s.mu.RLock()
+	ok = s.values.Contains(values...)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) ContainsOneOf(values ...T) (ok bool) {
+	s.mu.RLock()
+	ok = s.values.ContainsOneOf(values...)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Copy() TSafeNumberList[T] {
+	return newTSafeNumberList(s.NumberList())
+}
+
+func (s *tsafeNumberList[T]) Diff(s2 NumberList[T]) (out NumberList[T]) {
+	s.mu.RLock()
+	out = s.values.Diff(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Empty() (ok bool) {
+	s.mu.RLock()
+	ok = s.values.Empty()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Equal(s2 NumberList[T]) (ok bool) {
+	s.mu.RLock()
+	ok = s.values.Equal(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Excludes(s2 NumberList[T]) (out NumberList[T]) {
+	s.mu.RLock()
+	out = s.values.Excludes(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Filter(matcher func(v T) bool) (out NumberList[T]) {
+	s.mu.RLock()
+	out = s.values.Filter(matcher)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Find(matcher func(v T) bool) (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.Find(matcher)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) FindAll(matcher func(v T) bool) (out NumberList[T]) {
+	s.mu.RLock()
+	out = s.values.FindAll(matcher)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) First() (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.First()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Get(i int) (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.Get(i)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Intersect(s2 NumberList[T]) (out NumberList[T]) {
+	s.mu.RLock()
+	out = s.values.Intersect(s2)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Last() (v T, ok bool) {
+	s.mu.RLock()
+	v, ok = s.values.Last()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Len() (n int) {
+	s.mu.RLock()
+	n = s.values.Len()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) LenIf(f func(v T) bool) (n int) {
+	s.mu.RLock()
+	n = s.values.LenIf(f)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Take(n int) (out NumberList[T]) {
+	s.mu.RLock()
+	out = s.values.Take(n).Copy()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) S() (out []any) {
+	s.mu.RLock()
+	out = s.values.S()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Mean() (mean float64) {
+	s.mu.RLock()
+	mean = s.values.Mean()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) MeanIf(f func(v T) bool) (mean float64) {
+	s.mu.RLock()
+	mean = s.values.MeanIf(f)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Sum() (sum T) {
+	s.mu.RLock()
+	sum = s.values.Sum()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) SumIf(f func(v T) bool) (sum T) {
+	s.mu.RLock()
+	sum = s.values.SumIf(f)
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) NumberList() (out NumberList[T]) {
+	s.mu.RLock()
+	out = s.values.Copy()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Ints() (out Ints) {
+	s.mu.RLock()
+	out = s.values.Ints()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Int64s() (out Int64s) {
+	s.mu.RLock()
+	out = s.values.Int64s()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Uints() (out Uints) {
+	s.mu.RLock()
+	out = s.values.Uints()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Uint64s() (out Uint64s) {
+	s.mu.RLock()
+	out = s.values.Uint64s()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Floats() (out Floats) {
+	s.mu.RLock()
+	out = s.values.Floats()
+	s.mu.RUnlock()
+	return
+}
+
+func (s *tsafeNumberList[T]) Snapshot(w io.Writer, format SnapshotFormat) error {
+	return writeSnapshot(w, listKind[T](), format, s.NumberList())
+}
+
+func (s *tsafeNumberList[T]) Restore(r io.Reader) error {
+	values, err := readListSnapshot[T](r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.values = values
+	s.mu.Unlock()
+	return nil
+}
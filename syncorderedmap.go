@@ -0,0 +1,211 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// TSafeOrderedMap abstract the implementation of SyncOrderedMap.
+type TSafeOrderedMap interface {
+	// Add a new entry if the given key is not filled.
+	Add(string, any)
+
+	// Delete the key and say if it existed.
+	Delete(string) bool
+
+	// Find the first element matching the pattern.
+	Find(Matcher) (string, any, bool)
+
+	// FindAll elements matching the pattern.
+	FindAll(Matcher) *OrderedMap
+
+	// Get an element from the key.
+	Get(string) (any, bool)
+
+	// Keys return the list of keys in order.
+	Keys() []string
+
+	// Values return the list of values in order.
+	Values() []any
+
+	// Len returns the size of the map.
+	Len() int
+
+	// Map convert TSafeOrderedMap to Map.
+	Map() Map
+
+	// MoveToFront moves the key at the front and say if it exists.
+	MoveToFront(string) bool
+
+	// MoveToBack moves the key at the back and say if it exists.
+	MoveToBack(string) bool
+
+	// OrderedMap convert TSafeOrderedMap to OrderedMap.
+	OrderedMap() *OrderedMap
+
+	// Set a new entry or change an entry for the given key "k".
+	Set(string, any)
+
+	// Reset the values.
+	Reset()
+
+	// MarshalJSON writes the keys in order.
+	MarshalJSON() ([]byte, error)
+
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
+
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
+}
+
+// SyncOrderedMap return a new thread-safe OrderedMap.
+func SyncOrderedMap() TSafeOrderedMap {
+	return &tsafeOrderedMap{
+		&sync.RWMutex{},
+		NewOrderedMap(),
+	}
+}
+
+type tsafeOrderedMap struct {
+	mu     *sync.RWMutex
+	values *OrderedMap
+}
+
+func (m *tsafeOrderedMap) Add(k string, v any) {
+	m.mu.Lock()
+	m.values.Add(k, v)
+	m.mu.Unlock()
+}
+
+func (m *tsafeOrderedMap) Delete(k string) (ok bool) {
+	m.mu.Lock()
+	ok = m.values.Delete(k)
+	m.mu.Unlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Find(matcher Matcher) (k string, v any, ok bool) {
+	m.mu.RLock()
+	k, v, ok = m.values.Find(matcher)
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) FindAll(matcher Matcher) (out *OrderedMap) {
+	m.mu.RLock()
+	out = m.values.FindAll(matcher)
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Get(k string) (v any, ok bool) {
+	m.mu.RLock()
+	v, ok = m.values.Get(k)
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Keys() (out []string) {
+	m.mu.RLock()
+	out = m.values.Keys()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Values() (out []any) {
+	m.mu.RLock()
+	out = m.values.Values()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Len() (n int) {
+	m.mu.RLock()
+	n = m.values.Len()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Map() (out Map) {
+	m.mu.RLock()
+	out = m.values.Map()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) MoveToFront(k string) (ok bool) {
+	m.mu.Lock()
+	ok = m.values.MoveToFront(k)
+	m.mu.Unlock()
+	return
+}
+
+func (m *tsafeOrderedMap) MoveToBack(k string) (ok bool) {
+	m.mu.Lock()
+	ok = m.values.MoveToBack(k)
+	m.mu.Unlock()
+	return
+}
+
+func (m *tsafeOrderedMap) OrderedMap() (out *OrderedMap) {
+	m.mu.RLock()
+	out = m.values.Copy()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Set(k string, v any) {
+	m.mu.Lock()
+	m.values.Set(k, v)
+	m.mu.Unlock()
+}
+
+func (m *tsafeOrderedMap) Reset() {
+	m.mu.Lock()
+	m.values.Reset()
+	m.mu.Unlock()
+}
+
+func (m *tsafeOrderedMap) MarshalJSON() (b []byte, err error) {
+	m.mu.RLock()
+	b, err = m.values.MarshalJSON()
+	m.mu.RUnlock()
+	return
+}
+
+func (m *tsafeOrderedMap) Snapshot(w io.Writer, format SnapshotFormat) error {
+	return writeSnapshot(w, "ordered-map", format, m.OrderedMap())
+}
+
+func (m *tsafeOrderedMap) Restore(r io.Reader) error {
+	values := NewOrderedMap()
+	if err := readSnapshot(r, "ordered-map", &orderedSnapshot{values}); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.values = values
+	m.mu.Unlock()
+	return nil
+}
+
+// orderedSnapshot decodes the snapshots of an OrderedMap, JSON numbers are
+// converted like in the snapshots of TSafeMap.
+type orderedSnapshot struct {
+	m *OrderedMap
+}
+
+func (s *orderedSnapshot) UnmarshalJSON(b []byte) error {
+	return s.m.unmarshalJSON(b, func(n json.Number) any { return fromJSONValue(n) })
+}
+
+func (s *orderedSnapshot) GobDecode(b []byte) error {
+	return s.m.GobDecode(b)
+}
@@ -1,11 +1,32 @@
 package types
 
-import "sync"
+import "io"
 
 type TSafeStrings interface {
 	// Reset the slice.
 	Reset()
 
+	// Add new elements to the slice.
+	Add(...string)
+
+	// Set the element "i" and say if it exists.
+	Set(int, string) bool
+
+	// RemoveAt remove the element "i" and return it.
+	RemoveAt(int) (string, bool)
+
+	// Remove every occurrence of "values" and return the number of removed elements.
+	Remove(...string) int
+
+	// Pop remove the last element and return it.
+	Pop() (string, bool)
+
+	// Shift remove the first element and return it.
+	Shift() (string, bool)
+
+	// Update run "f" on the slice under a single write lock.
+	Update(func(*Strings))
+
 	// Contains say if "s" contains "values".
 	Contains(...string) bool
 
@@ -53,132 +74,27 @@ type TSafeStrings interface {
 
 	// S convert s into Strings
 	Strings() Strings
-}
-
-func SyncStrings() TSafeStrings {
-	return &tsafeStrings{&sync.RWMutex{}, Strings{}}
-}
-
-type tsafeStrings struct {
-	mu     *sync.RWMutex
-	values Strings
-}
-
-func (s *tsafeStrings) Reset() {
-	s.mu.Lock()
-	s.values.Reset()
-	s.mu.Unlock()
-}
 
-func (s *tsafeStrings) Contains(values ...string) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Contains(values...)
-	s.mu.RUnlock()
-	return
-}
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
 
-func (s *tsafeStrings) ContainsOneOf(values ...string) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.ContainsOneOf(values...)
-	s.mu.RUnlock()
-	return
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
 }
 
-func (s *tsafeStrings) Copy() TSafeStrings {
-	s2 := &tsafeStrings{mu: &sync.RWMutex{}}
-	s.mu.RLock()
-	s2.values = s.values.Copy()
-	s.mu.RUnlock()
-	return s2
-}
-
-func (s *tsafeStrings) Diff(s2 Strings) (out Strings) {
-	s.mu.RLock()
-	out = s.values.Diff(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) Empty() (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Empty()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) Equal(s2 Strings) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Equal(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) Find(matcher func(v string) bool) (v string, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Find(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) FindAll(matcher func(v string) bool) (v Strings) {
-	s.mu.RLock()
-	v = s.values.FindAll(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) First() (v string, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.First()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) Get(i int) (v string, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Get(i)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) Intersect(s2 Strings) (v Strings) {
-	s.mu.RLock()
-	v = s.values.Intersect(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) Last() (v string, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Last()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeStrings) Len() (v int) {
-	s.mu.RLock()
-	v = s.values.Len()
-	s.mu.RUnlock()
-	return
+// SyncStrings return a new thread-safe Strings.
+func SyncStrings() TSafeStrings {
+	return tsafeStrings{newTSafeList(Strings{})}
 }
 
-func (s *tsafeStrings) Take(n int) (v Strings) {
-	s.mu.RLock()
-	v = s.values.Take(n)
-	s.mu.RUnlock()
-	return
+type tsafeStrings struct {
+	*tsafeList[string]
 }
 
-func (s *tsafeStrings) S() (out []any) {
-	s.mu.RLock()
-	out = s.values.S()
-	s.mu.RUnlock()
-	return
+func (s tsafeStrings) Copy() TSafeStrings {
+	return tsafeStrings{newTSafeList(s.List())}
 }
 
-func (s *tsafeStrings) Strings() (v Strings) {
-	s.mu.RLock()
-	v = s.values.Copy()
-	s.mu.RUnlock()
-	return
+func (s tsafeStrings) Strings() Strings {
+	return s.List()
 }
@@ -1,13 +1,32 @@
 package types
 
-import (
-	"sync"
-)
+import "io"
 
 type TSafeUint64s interface {
 	// Reset the slice.
 	Reset()
 
+	// Add new elements to the slice.
+	Add(...uint64)
+
+	// Set the element "i" and say if it exists.
+	Set(int, uint64) bool
+
+	// RemoveAt remove the element "i" and return it.
+	RemoveAt(int) (uint64, bool)
+
+	// Remove every occurrence of "values" and return the number of removed elements.
+	Remove(...uint64) int
+
+	// Pop remove the last element and return it.
+	Pop() (uint64, bool)
+
+	// Shift remove the first element and return it.
+	Shift() (uint64, bool)
+
+	// Update run "f" on the slice under a single write lock.
+	Update(func(*Uint64s))
+
 	// Contains say if "s" contains "values".
 	Contains(...uint64) bool
 
@@ -55,132 +74,23 @@ type TSafeUint64s interface {
 
 	// S convert s into Uint64s
 	Uint64s() Uint64s
-}
-
-func SyncUint64s() TSafeUint64s {
-	return &tsafeUint64s{&sync.RWMutex{}, Uint64s{}}
-}
 
-type tsafeUint64s struct {
-	mu     *sync.RWMutex
-	values Uint64s
-}
-
-func (s *tsafeUint64s) Reset() {
-	s.mu.Lock()
-	s.values.Reset()
-	s.mu.Unlock()
-}
-
-func (s *tsafeUint64s) Contains(values ...uint64) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Contains(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) ContainsOneOf(values ...uint64) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.ContainsOneOf(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Copy() TSafeUint64s {
-	s2 := &tsafeUint64s{mu: &sync.RWMutex{}}
-	s.mu.RLock()
-	s2.values = s.values.Copy()
-	s.mu.RUnlock()
-	return s2
-}
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
 
-func (s *tsafeUint64s) Diff(s2 Uint64s) (out Uint64s) {
-	s.mu.RLock()
-	out = s.values.Diff(s2)
-	s.mu.RUnlock()
-	return
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
 }
 
-func (s *tsafeUint64s) Empty() (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Empty()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Equal(s2 Uint64s) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Equal(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Find(matcher func(v uint64) bool) (v uint64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Find(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) FindAll(matcher func(v uint64) bool) (v Uint64s) {
-	s.mu.RLock()
-	v = s.values.FindAll(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) First() (v uint64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.First()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Get(i int) (v uint64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Get(i)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Intersect(s2 Uint64s) (v Uint64s) {
-	s.mu.RLock()
-	v = s.values.Intersect(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Last() (v uint64, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Last()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Len() (v int) {
-	s.mu.RLock()
-	v = s.values.Len()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUint64s) Take(n int) (v Uint64s) {
-	s.mu.RLock()
-	v = s.values.Take(n)
-	s.mu.RUnlock()
-	return
+// SyncUint64s return a new thread-safe Uint64s.
+func SyncUint64s() TSafeUint64s {
+	return tsafeUint64s{newTSafeNumberList(Uint64s{})}
 }
 
-func (s *tsafeUint64s) S() (out []any) {
-	s.mu.RLock()
-	out = s.values.S()
-	s.mu.RUnlock()
-	return
+type tsafeUint64s struct {
+	*tsafeNumberList[uint64]
 }
 
-func (s *tsafeUint64s) Uint64s() (v Uint64s) {
-	s.mu.RLock()
-	v = s.values.Copy()
-	s.mu.RUnlock()
-	return
+func (s tsafeUint64s) Copy() TSafeUint64s {
+	return tsafeUint64s{newTSafeNumberList(s.NumberList())}
 }
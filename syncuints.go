@@ -1,11 +1,32 @@
 package types
 
-import "sync"
+import "io"
 
 type TSafeUints interface {
 	// Reset the slice.
 	Reset()
 
+	// Add new elements to the slice.
+	Add(...uint)
+
+	// Set the element "i" and say if it exists.
+	Set(int, uint) bool
+
+	// RemoveAt remove the element "i" and return it.
+	RemoveAt(int) (uint, bool)
+
+	// Remove every occurrence of "values" and return the number of removed elements.
+	Remove(...uint) int
+
+	// Pop remove the last element and return it.
+	Pop() (uint, bool)
+
+	// Shift remove the first element and return it.
+	Shift() (uint, bool)
+
+	// Update run "f" on the slice under a single write lock.
+	Update(func(*Uints))
+
 	// Contains say if "s" contains "values".
 	Contains(...uint) bool
 
@@ -53,132 +74,23 @@ type TSafeUints interface {
 
 	// S convert s into Uints
 	Uints() Uints
-}
-
-func SyncUints() TSafeUints {
-	return &tsafeUints{&sync.RWMutex{}, Uints{}}
-}
 
-type tsafeUints struct {
-	mu     *sync.RWMutex
-	values Uints
-}
-
-func (s *tsafeUints) Reset() {
-	s.mu.Lock()
-	s.values.Reset()
-	s.mu.Unlock()
-}
-
-func (s *tsafeUints) Contains(values ...uint) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Contains(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) ContainsOneOf(values ...uint) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.ContainsOneOf(values...)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Copy() TSafeUints {
-	s2 := &tsafeUints{mu: &sync.RWMutex{}}
-	s.mu.RLock()
-	s2.values = s.values.Copy()
-	s.mu.RUnlock()
-	return s2
-}
+	// Snapshot writes a consistent copy of the values to "w".
+	Snapshot(w io.Writer, format SnapshotFormat) error
 
-func (s *tsafeUints) Diff(s2 Uints) (out Uints) {
-	s.mu.RLock()
-	out = s.values.Diff(s2)
-	s.mu.RUnlock()
-	return
+	// Restore replaces the values with the snapshot read from "r".
+	Restore(r io.Reader) error
 }
 
-func (s *tsafeUints) Empty() (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Empty()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Equal(s2 Uints) (ok bool) {
-	s.mu.RLock()
-	ok = s.values.Equal(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Find(matcher func(v uint) bool) (v uint, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Find(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) FindAll(matcher func(v uint) bool) (v Uints) {
-	s.mu.RLock()
-	v = s.values.FindAll(matcher)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) First() (v uint, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.First()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Get(i int) (v uint, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Get(i)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Intersect(s2 Uints) (v Uints) {
-	s.mu.RLock()
-	v = s.values.Intersect(s2)
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Last() (v uint, ok bool) {
-	s.mu.RLock()
-	v, ok = s.values.Last()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Len() (v int) {
-	s.mu.RLock()
-	v = s.values.Len()
-	s.mu.RUnlock()
-	return
-}
-
-func (s *tsafeUints) Take(n int) (v Uints) {
-	s.mu.RLock()
-	v = s.values.Take(n)
-	s.mu.RUnlock()
-	return
+// SyncUints return a new thread-safe Uints.
+func SyncUints() TSafeUints {
+	return tsafeUints{newTSafeNumberList(Uints{})}
 }
 
-func (s *tsafeUints) S() (out []any) {
-	s.mu.RLock()
-	out = s.values.S()
-	s.mu.RUnlock()
-	return
+type tsafeUints struct {
+	*tsafeNumberList[uint]
 }
 
-func (s *tsafeUints) Uints() (v Uints) {
-	s.mu.RLock()
-	v = s.values.Copy()
-	s.mu.RUnlock()
-	return
+func (s tsafeUints) Copy() TSafeUints {
+	return tsafeUints{newTSafeNumberList(s.NumberList())}
 }
@@ -8,6 +8,7 @@ package types
 import (
 	"bytes"
 	"database/sql/driver"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -25,8 +26,23 @@ func NewDate(t time.Time) Date {
 
 // ParseDate returns the Date of the given string.
 func ParseDate(t string) Date {
-	dt, _ := time.Parse(DateFormat, t)
-	return NewDate(dt)
+	d, _ := ParseDateE(t)
+	return d
+}
+
+// ParseDateE returns the Date of the given string or a *ParseError.
+func ParseDateE(t string) (Date, error) {
+	dt, err := parseTime("Date", t, time.UTC, DateFormat)
+	return NewDate(dt), err
+}
+
+// MustParseDate is like ParseDateE but panics on error.
+func MustParseDate(t string) Date {
+	d, err := ParseDateE(t)
+	if err != nil {
+		panic(err)
+	}
+	return d
 }
 
 // Date is a wrapper around time.Time.
@@ -34,6 +50,7 @@ type Date struct {
 	time.Time
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface.
 func (d *Date) UnmarshalJSON(b []byte) (err error) {
 	s := strings.Trim(string(b), "\"")
 	if s == "null" {
@@ -41,7 +58,18 @@ func (d *Date) UnmarshalJSON(b []byte) (err error) {
 		return
 	}
 
-	d.Time, err = time.Parse(DateFormat, s)
+	d.Time, err = parseTime("Date", s, time.UTC, DateFormat)
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *Date) UnmarshalText(b []byte) (err error) {
+	d.Time, err = parseTime("Date", string(b), time.UTC, DateFormat)
 	return
 }
 
@@ -64,6 +92,12 @@ func (d Date) Value() (driver.Value, error) {
 	return d.String(), nil
 }
 
+// Scan implements the sql.Scanner interface.
+func (d *Date) Scan(src any) (err error) {
+	d.Time, err = scanTime(src, "Date", time.UTC, DateFormat)
+	return
+}
+
 // NewDateYearMonth returns a new date from a time.Time.
 func NewDateYearMonth(t time.Time) DateYearMonth {
 	return DateYearMonth{t}
@@ -71,8 +105,23 @@ func NewDateYearMonth(t time.Time) DateYearMonth {
 
 // ParseDateYearMonth returns the DateYearMonth of the given string.
 func ParseDateYearMonth(t string) DateYearMonth {
-	dt, _ := time.Parse(DateYearMonthFormat, t)
-	return NewDateYearMonth(dt)
+	d, _ := ParseDateYearMonthE(t)
+	return d
+}
+
+// ParseDateYearMonthE returns the DateYearMonth of the given string or a *ParseError.
+func ParseDateYearMonthE(t string) (DateYearMonth, error) {
+	dt, err := parseTime("DateYearMonth", t, time.UTC, DateYearMonthFormat)
+	return NewDateYearMonth(dt), err
+}
+
+// MustParseDateYearMonth is like ParseDateYearMonthE but panics on error.
+func MustParseDateYearMonth(t string) DateYearMonth {
+	d, err := ParseDateYearMonthE(t)
+	if err != nil {
+		panic(err)
+	}
+	return d
 }
 
 // DateYearMonth is a wrapper around time.Time.
@@ -80,6 +129,29 @@ type DateYearMonth struct {
 	time.Time
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *DateYearMonth) UnmarshalJSON(b []byte) (err error) {
+	s := strings.Trim(string(b), "\"")
+	if s == "null" {
+		d.Time = time.Time{}
+		return
+	}
+
+	d.Time, err = parseTime("DateYearMonth", s, time.UTC, DateYearMonthFormat)
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d DateYearMonth) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *DateYearMonth) UnmarshalText(b []byte) (err error) {
+	d.Time, err = parseTime("DateYearMonth", string(b), time.UTC, DateYearMonthFormat)
+	return
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (d DateYearMonth) MarshalJSON() ([]byte, error) {
 	b := bytes.NewBuffer([]byte{})
@@ -99,15 +171,59 @@ func (d DateYearMonth) Value() (driver.Value, error) {
 	return d.Format(DateFormat), nil
 }
 
+// Scan implements the sql.Scanner interface.
+func (d *DateYearMonth) Scan(src any) (err error) {
+	d.Time, err = scanTime(src, "DateYearMonth", time.UTC, DateFormat, DateYearMonthFormat)
+	return
+}
+
+// DateTimeLocation is the location DateTime values are parsed in and written
+// in, unless the value has its own location (see NewDateTimeIn). Values in
+// UTC or in time.Local have no location of their own.
+var DateTimeLocation = time.UTC
+
+// DateTimeUTC normalises DateTime values to UTC when they are written,
+// strings are then parsed back as UTC to keep the same instant.
+var DateTimeUTC = false
+
 // NewDateTime create a new DateTime from a time.Time.
 func NewDateTime(t time.Time) DateTime {
 	return DateTime{t}
 }
 
+// NewDateTimeIn create a new DateTime from a time.Time, parsed and written in "loc".
+// The zero time gives an empty DateTime to scan or unmarshal into in "loc".
+func NewDateTimeIn(t time.Time, loc *time.Location) DateTime {
+	return DateTime{t.In(loc)}
+}
+
 // ParseDateTime returns the DateTime of the given string.
 func ParseDateTime(t string) DateTime {
-	dt, _ := time.Parse(DateTimeFormat, t)
-	return NewDateTime(dt)
+	d, _ := ParseDateTimeE(t)
+	return d
+}
+
+// ParseDateTimeE returns the DateTime of the given string or a *ParseError.
+func ParseDateTimeE(t string) (DateTime, error) {
+	d := DateTime{}
+	err := d.parse(t)
+	return d, err
+}
+
+// MustParseDateTime is like ParseDateTimeE but panics on error.
+func MustParseDateTime(t string) DateTime {
+	d, err := ParseDateTimeE(t)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// ParseDateTimeIn returns the DateTime of the given string in "loc".
+func ParseDateTimeIn(t string, loc *time.Location) DateTime {
+	d := NewDateTimeIn(time.Time{}, loc)
+	d.parse(t)
+	return d
 }
 
 // DateTime is a wrapper around time.Time.
@@ -115,21 +231,123 @@ type DateTime struct {
 	time.Time
 }
 
+// ownLocation returns the location of the time, nil for UTC and time.Local.
+func (d DateTime) ownLocation() *time.Location {
+	if loc := d.Location(); loc != time.UTC && loc != time.Local {
+		return loc
+	}
+	return nil
+}
+
+// location returns the location the value is expressed in.
+func (d DateTime) location() *time.Location {
+	if loc := d.ownLocation(); loc != nil {
+		return loc
+	}
+	if DateTimeLocation != nil {
+		return DateTimeLocation
+	}
+	return time.UTC
+}
+
+// wireLocation returns the location the value is written and parsed in.
+func (d DateTime) wireLocation() *time.Location {
+	if DateTimeUTC {
+		return time.UTC
+	}
+	return d.location()
+}
+
+// clear sets the zero time, the value keeps its own location.
+func (d *DateTime) clear() {
+	loc := d.ownLocation()
+	d.Time = time.Time{}
+	if loc != nil {
+		d.Time = d.In(loc)
+	}
+}
+
+// parse the string "s" written in the wire location.
+func (d *DateTime) parse(s string) error {
+	t, err := parseTime("DateTime", s, d.wireLocation(), DateTimeFormat)
+	if err != nil {
+		d.clear()
+		return err
+	}
+	d.Time = t.In(d.location())
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *DateTime) UnmarshalJSON(b []byte) (err error) {
+	s := strings.Trim(string(b), "\"")
+	if s == "null" {
+		d.clear()
+		return
+	}
+
+	return d.parse(s)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d DateTime) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *DateTime) UnmarshalText(b []byte) error {
+	return d.parse(string(b))
+}
+
 // MarshalJSON implements the json.Marshaler interface.
 func (d DateTime) MarshalJSON() ([]byte, error) {
 	b := bytes.NewBuffer([]byte{})
 	b.WriteRune('"')
-	b.WriteString(d.Format(DateTimeFormat))
+	b.WriteString(d.String())
 	b.WriteRune('"')
 	return b.Bytes(), nil
 }
 
-// String returns the string representation of the datetime.
+// String returns the string representation of the datetime in the wire location.
 func (d DateTime) String() string {
-	return d.Format(DateTimeFormat)
+	if d.IsZero() {
+		return d.Format(DateTimeFormat)
+	}
+	return d.In(d.wireLocation()).Format(DateTimeFormat)
 }
 
 // Value implements the database.Valuer interface.
 func (d DateTime) Value() (driver.Value, error) {
 	return d.String(), nil
 }
+
+// Scan implements the sql.Scanner interface.
+func (d *DateTime) Scan(src any) (err error) {
+	t, err := scanTime(src, "DateTime", d.wireLocation(), DateTimeFormat)
+	if err != nil || t.IsZero() {
+		d.clear()
+		return
+	}
+	d.Time = t.In(d.location())
+	return
+}
+
+// scanTime converts a value coming from a database driver into a time.Time,
+// strings and bytes are parsed with the first matching layout.
+func scanTime(src any, name string, loc *time.Location, layouts ...string) (time.Time, error) {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return time.Time{}, fmt.Errorf("types: cannot scan %T into %s", src, name)
+	}
+
+	return parseTime(name, s, loc, layouts...)
+}
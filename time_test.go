@@ -0,0 +1,158 @@
+package types
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_Scan(t *testing.T) {
+	expected := time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC)
+
+	for _, src := range []any{"2020-05-17", []byte("2020-05-17"), expected} {
+		d := Date{}
+		assert.NoError(t, fakeScan(t, src, &d))
+		assert.Equal(t, expected, d.Time)
+	}
+
+	d := Date{}
+	assert.Error(t, fakeScan(t, "17/05/2020", &d))
+	assert.Error(t, fakeScan(t, int64(12), &d))
+}
+
+func TestDateYearMonth_Scan(t *testing.T) {
+	expected := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	d := DateYearMonth{}
+
+	v, err := NewDateYearMonth(expected).Value()
+	assert.NoError(t, err)
+	assert.NoError(t, fakeScan(t, v, &d))
+	assert.Equal(t, expected, d.Time)
+
+	assert.NoError(t, fakeScan(t, "2020-05", &d))
+	assert.Equal(t, expected, d.Time)
+	assert.Error(t, fakeScan(t, true, &d))
+}
+
+func TestDateTime_Scan(t *testing.T) {
+	expected := time.Date(2020, 5, 17, 13, 4, 5, 0, time.UTC)
+	d := DateTime{}
+
+	v, err := NewDateTime(expected).Value()
+	assert.NoError(t, err)
+	assert.NoError(t, fakeScan(t, v, &d))
+	assert.Equal(t, expected, d.Time)
+
+	assert.NoError(t, fakeScan(t, []byte("2020-05-17 13:04:05"), &d))
+	assert.Equal(t, expected, d.Time)
+
+	err = fakeScan(t, "2020-05-17", &d)
+	assert.ErrorContains(t, err, DateTimeFormat)
+}
+
+func TestDateTime_JSON(t *testing.T) {
+	type payload struct {
+		At    DateTime      `json:"at"`
+		Month DateYearMonth `json:"month"`
+		Day   Date          `json:"day"`
+	}
+
+	in := payload{
+		At:    NewDateTime(time.Date(2020, 5, 17, 13, 4, 5, 0, time.UTC)),
+		Month: NewDateYearMonth(time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)),
+		Day:   NewDate(time.Date(2020, 5, 17, 0, 0, 0, 0, time.UTC)),
+	}
+
+	b, err := json.Marshal(in)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"at":"2020-05-17 13:04:05","month":"2020-05","day":"2020-05-17"}`, string(b))
+
+	out := payload{}
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+
+	out = payload{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"at":null,"month":null,"day":null}`), &out))
+	assert.True(t, out.At.IsZero())
+	assert.True(t, out.Month.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`{"at":"2020-05-17T13:04:05Z"}`), &out))
+}
+
+func TestDateTime_Text(t *testing.T) {
+	var _ encoding.TextMarshaler = Date{}
+	var _ encoding.TextUnmarshaler = &Date{}
+
+	d := DateTime{}
+	assert.NoError(t, d.UnmarshalText([]byte("2020-05-17 13:04:05")))
+	text, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-05-17 13:04:05", string(text))
+
+	ym := DateYearMonth{}
+	assert.NoError(t, ym.UnmarshalText([]byte("2020-05")))
+	assert.Equal(t, time.May, ym.Month())
+	text, err = ym.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-05", string(text))
+
+	day := Date{}
+	assert.Error(t, day.UnmarshalText([]byte("2020-05-17 13:04:05")))
+}
+
+func TestDateTime_Location(t *testing.T) {
+	paris := time.FixedZone("Paris", 2*3600)
+	instant := time.Date(2020, 5, 17, 11, 4, 5, 0, time.UTC)
+
+	d := NewDateTimeIn(instant, paris)
+	assert.Equal(t, "2020-05-17 13:04:05", d.String())
+
+	d2 := ParseDateTimeIn(d.String(), paris)
+	assert.True(t, instant.Equal(d2.Time))
+	assert.Equal(t, paris, d2.Location())
+
+	v, err := d.Value()
+	assert.NoError(t, err)
+	d3 := NewDateTimeIn(time.Time{}, paris)
+	assert.NoError(t, fakeScan(t, v, &d3))
+	assert.True(t, instant.Equal(d3.Time))
+
+	// The location is held by the time, the struct keeps its shape.
+	assert.True(t, DateTime{instant} == NewDateTime(instant))
+	assert.Equal(t, paris, d3.Location())
+
+	// A failed parse keeps the location of the value.
+	assert.Error(t, d3.UnmarshalText([]byte("17/05/2020")))
+	assert.True(t, d3.IsZero())
+	assert.NoError(t, d3.UnmarshalText([]byte("2020-05-17 13:04:05")))
+	assert.True(t, instant.Equal(d3.Time))
+}
+
+func TestDateTime_GlobalLocation(t *testing.T) {
+	defer func(loc *time.Location, utc bool) {
+		DateTimeLocation, DateTimeUTC = loc, utc
+	}(DateTimeLocation, DateTimeUTC)
+
+	tokyo := time.FixedZone("Tokyo", 9*3600)
+	instant := time.Date(2020, 5, 17, 11, 4, 5, 0, time.UTC)
+	DateTimeLocation = tokyo
+
+	d := ParseDateTime("2020-05-17 20:04:05")
+	assert.True(t, instant.Equal(d.Time))
+
+	b, err := json.Marshal(NewDateTime(instant))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2020-05-17 20:04:05"`, string(b))
+
+	DateTimeUTC = true
+	b, err = json.Marshal(NewDateTime(instant.In(tokyo)))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2020-05-17 11:04:05"`, string(b))
+
+	out := DateTime{}
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.True(t, instant.Equal(out.Time))
+	assert.Equal(t, tokyo, out.Location())
+}
@@ -0,0 +1,217 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValuesOption configures MapFromValues.
+type ValuesOption func(*valuesConfig)
+
+type valuesConfig struct {
+	infer bool
+}
+
+// WithTypeInference converts the values looking like booleans, integers and
+// floats, the other values are kept as strings.
+func WithTypeInference() ValuesOption {
+	return func(c *valuesConfig) {
+		c.infer = true
+	}
+}
+
+// MapFromValues builds a Map from form or query values. Keys in bracket
+// notation are nested, "a[b][c]=1" sets a map and "a[0]=1" a slice, while
+// "ids[]=1&ids[]=2" and repeated keys set a list of values. Indexes can't
+// be greater than MaxIndex or the number of keys, whichever is higher.
+func MapFromValues(values url.Values, opts ...ValuesOption) (Map, error) {
+	c := &valuesConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := Map{}
+	for _, k := range keys {
+		segs, list, err := parseValuesKey(k)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkIndexes(segs, len(values)); err != nil {
+			return nil, fmt.Errorf("types: cannot set value %q: %w", k, err)
+		}
+
+		vals := values[k]
+		if len(vals) == 0 {
+			continue
+		}
+
+		var v any
+		if list || len(vals) > 1 {
+			v = c.list(vals)
+		} else {
+			v = c.value(vals[0])
+		}
+
+		if err := unflattenSet(out, segs, v); err != nil {
+			return nil, fmt.Errorf("types: cannot set value %q: %w", k, err)
+		}
+	}
+	return finishUnflatten(out).(Map), nil
+}
+
+func (c *valuesConfig) value(s string) any {
+	if c.infer {
+		return inferValue(s)
+	}
+	return s
+}
+
+func (c *valuesConfig) list(vals []string) any {
+	if !c.infer {
+		return Strings(vals).Copy()
+	}
+
+	out := make([]any, len(vals))
+	for i, s := range vals {
+		out[i] = inferValue(s)
+	}
+	return out
+}
+
+// parseValuesKey splits "a[b][0][]" into its segments, "list" says if the
+// key ends with "[]".
+func parseValuesKey(k string) (segs []flatSegment, list bool, err error) {
+	name, rest := k, ""
+	if i := strings.IndexByte(k, '['); i >= 0 {
+		name, rest = k[:i], k[i:]
+	}
+	if name == "" {
+		return nil, false, fmt.Errorf("%w: missing name in %q", ErrInvalidPath, k)
+	}
+
+	segs = append(segs, flatSegment{key: name})
+	for rest != "" {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 || list {
+			return nil, false, fmt.Errorf("%w: malformed key %q", ErrInvalidPath, k)
+		}
+
+		switch inner := rest[1:end]; {
+		case inner == "":
+			list = true
+		case isIndex(inner):
+			n, _ := strconv.Atoi(inner)
+			segs = append(segs, flatSegment{index: n, isIdx: true})
+		default:
+			segs = append(segs, flatSegment{key: inner})
+		}
+		rest = rest[end+1:]
+	}
+	return
+}
+
+func isIndex(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0 && strconv.Itoa(n) == s
+}
+
+// inferValue converts "s" into a bool, an int or a float64 when it looks
+// like one. Numbers with leading zeros are kept as strings.
+func inferValue(s string) any {
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	digits := strings.TrimPrefix(s, "-")
+	if digits == "" || digits[0] < '0' || digits[0] > '9' || (len(digits) > 1 && digits[0] == '0' && digits[1] != '.') {
+		return s
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsInf(f, 0) && !strings.ContainsAny(s, "xX_") {
+		return f
+	}
+	return s
+}
+
+// URLValues encodes the map in bracket notation, the reverse of MapFromValues.
+// Keys are visited in sorted order so the result is deterministic. Lists of
+// scalars are written as "ids[]", other slices with their indexes.
+func (m Map) URLValues() url.Values {
+	out := url.Values{}
+	for _, k := range sortedKeys(m) {
+		encodeValues(out, k, m[k])
+	}
+	return out
+}
+
+func encodeValues(out url.Values, prefix string, v any) {
+	if sub, err := ToMap(v); err == nil {
+		for _, k := range sortedKeys(sub) {
+			encodeValues(out, prefix+"["+k+"]", sub[k])
+		}
+		return
+	}
+
+	if !isContainer(v) {
+		out.Add(prefix, valueString(v))
+		return
+	}
+
+	rv := reflect.ValueOf(v)
+	scalars := true
+	for i := 0; i < rv.Len() && scalars; i++ {
+		scalars = !isContainer(rv.Index(i).Interface())
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		if e := rv.Index(i).Interface(); scalars {
+			out.Add(prefix+"[]", valueString(e))
+		} else {
+			encodeValues(out, prefix+"["+strconv.Itoa(i)+"]", e)
+		}
+	}
+}
+
+// isContainer says if "v" is a map or a slice other than []byte.
+func isContainer(v any) bool {
+	if _, err := ToMap(v); err == nil {
+		return true
+	}
+	_, ok := v.([]byte)
+	return !ok && isSlice(v)
+}
+
+func valueString(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return t.Format(time.RFC3339Nano)
+	}
+	if s, err := ToString(v); err == nil {
+		return s
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapFromValues(t *testing.T) {
+	values, err := url.ParseQuery("name=app&a[b][c]=1&ids[]=1&ids[]=2&tag=x&tag=y&items[1][id]=2&items[0][id]=1")
+	assert.NoError(t, err)
+
+	m, err := MapFromValues(values)
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		"name":  "app",
+		"a":     Map{"b": Map{"c": "1"}},
+		"ids":   Strings{"1", "2"},
+		"tag":   Strings{"x", "y"},
+		"items": []any{Map{"id": "1"}, Map{"id": "2"}},
+	}, m)
+
+	v, _ := m.Path("a.b.c")
+	assert.Equal(t, "1", v)
+	assert.Equal(t, 1, m.Map("a").Map("b").Int("c"))
+}
+
+func TestMapFromValuesSparse(t *testing.T) {
+	values, err := url.ParseQuery("items[1][id]=2&items[1][qty]=1")
+	assert.NoError(t, err)
+
+	m, err := MapFromValues(values)
+	assert.NoError(t, err)
+	assert.Equal(t, Map{"items": []any{nil, Map{"id": "2", "qty": "1"}}}, m)
+}
+
+func TestMapFromValuesInference(t *testing.T) {
+	values, err := url.ParseQuery("n=12&f=1.5&neg=-3&ok=true&no=false&zip=0042&s=abc&ids[]=1&ids[]=x&inf=Inf&hex=0x10")
+	assert.NoError(t, err)
+
+	m, err := MapFromValues(values, WithTypeInference())
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		"n":   12,
+		"f":   1.5,
+		"neg": -3,
+		"ok":  true,
+		"no":  false,
+		"zip": "0042",
+		"s":   "abc",
+		"ids": []any{1, "x"},
+		"inf": "Inf",
+		"hex": "0x10",
+	}, m)
+}
+
+func TestMapFromValuesErrors(t *testing.T) {
+	for _, q := range []string{"[a]=1", "a[b=1", "a[]x=1", "a[][b]=1"} {
+		values, err := url.ParseQuery(q)
+		assert.NoError(t, err)
+
+		_, err = MapFromValues(values)
+		assert.ErrorIs(t, err, ErrInvalidPath, q)
+	}
+
+	_, err := MapFromValues(url.Values{"a": {"1"}, "a[b]": {"2"}})
+	assert.ErrorIs(t, err, ErrNotContainer)
+
+	for _, q := range []string{"a[20000000]=x", "a[1001]=x", "a[0][5000]=x&b=1"} {
+		values, err := url.ParseQuery(q)
+		assert.NoError(t, err)
+
+		_, err = MapFromValues(values)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange, q)
+	}
+}
+
+func TestMap_URLValues(t *testing.T) {
+	m := Map{
+		"name":  "app",
+		"a":     map[string]any{"b": Map{"c": 1}},
+		"ids":   Ints{1, 2},
+		"items": []any{Map{"id": 1}, Map{"id": 2, "ok": true}},
+		"none":  nil,
+	}
+
+	values := m.URLValues()
+	assert.Equal(t, url.Values{
+		"name":         {"app"},
+		"a[b][c]":      {"1"},
+		"ids[]":        {"1", "2"},
+		"items[0][id]": {"1"},
+		"items[1][id]": {"2"},
+		"items[1][ok]": {"true"},
+		"none":         {""},
+	}, values)
+	assert.Equal(t, "a%5Bb%5D%5Bc%5D=1&ids%5B%5D=1&ids%5B%5D=2&items%5B0%5D%5Bid%5D=1&items%5B1%5D%5Bid%5D=2&items%5B1%5D%5Bok%5D=true&name=app&none=", values.Encode())
+
+	out, err := MapFromValues(values, WithTypeInference())
+	assert.NoError(t, err)
+	assert.Equal(t, Map{
+		"name":  "app",
+		"a":     Map{"b": Map{"c": 1}},
+		"ids":   []any{1, 2},
+		"items": []any{Map{"id": 1}, Map{"id": 2, "ok": true}},
+		"none":  "",
+	}, out)
+}
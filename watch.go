@@ -0,0 +1,264 @@
+// Copyright © 2026 Alexandre Kovac <contact@kovacou.com>.
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// EventType is the kind of change notified by Watch.
+type EventType int
+
+const (
+	// EventAdd is sent when a key is created.
+	EventAdd EventType = iota + 1
+
+	// EventSet is sent when the value of an existing key is replaced.
+	EventSet
+
+	// EventDelete is sent when a key is removed.
+	EventDelete
+
+	// EventReset is sent when the map is reset.
+	EventReset
+)
+
+var eventTypeNames = map[EventType]string{
+	EventAdd:    "add",
+	EventSet:    "set",
+	EventDelete: "delete",
+	EventReset:  "reset",
+}
+
+// String implements the fmt.Stringer interface.
+func (t EventType) String() string {
+	if name, ok := eventTypeNames[t]; ok {
+		return name
+	}
+	return "EventType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// Event is a change of a watched map.
+type Event struct {
+	Type EventType
+
+	// Key is the changed key, it is empty for EventReset.
+	Key string
+
+	// Old is the previous value for EventSet and EventDelete. For EventReset,
+	// it is the Map of the removed entries matched by the subscription.
+	Old any
+
+	// New is the value for EventAdd and EventSet.
+	New any
+}
+
+// DropPolicy says what to do when the buffer of a subscription is full.
+type DropPolicy int
+
+const (
+	// DropNewest drops the incoming event.
+	DropNewest DropPolicy = iota
+
+	// DropOldest drops the oldest buffered event to make room.
+	DropOldest
+
+	// Block waits for the subscriber, blocking the writers of the map.
+	Block
+)
+
+// DefaultWatchBuffer is the size of the buffer of a subscription.
+const DefaultWatchBuffer = 64
+
+// WatchOption configures a subscription.
+type WatchOption func(*Subscription)
+
+// WithBuffer sets the size of the buffer of the subscription, a negative
+// size is taken as 0. Without buffer, events are only delivered to a
+// subscriber waiting on C and DropOldest behaves like DropNewest.
+func WithBuffer(n int) WatchOption {
+	return func(s *Subscription) {
+		s.buffer = n
+	}
+}
+
+// WithDropPolicy sets what to do when the buffer is full, DropNewest by default.
+func WithDropPolicy(policy DropPolicy) WatchOption {
+	return func(s *Subscription) {
+		s.policy = policy
+	}
+}
+
+// KeyPrefix returns a Matcher matching the keys starting with "prefix".
+func KeyPrefix(prefix string) Matcher {
+	return func(k string, _ any) bool {
+		return strings.HasPrefix(k, prefix)
+	}
+}
+
+// Subscription receives the events of a watched map on C, in the order the
+// writes were applied. C is closed by Unsubscribe.
+type Subscription struct {
+	C <-chan Event
+
+	ch      chan Event
+	matcher Matcher
+	buffer  int
+	policy  DropPolicy
+	dropped atomic.Uint64
+	done    chan struct{}
+	once    sync.Once
+	hub     *watchHub
+}
+
+// Dropped returns the number of events dropped because the buffer was full.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Unsubscribe stops the subscription and closes C, it can be called many times.
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.done)
+		s.hub.remove(s)
+		close(s.ch)
+	})
+}
+
+func (s *Subscription) match(k string, v any) bool {
+	return s.matcher == nil || s.matcher(k, v)
+}
+
+func (s *Subscription) send(e Event) {
+	switch s.policy {
+	case Block:
+		select {
+		case s.ch <- e:
+		case <-s.done:
+		}
+		return
+	case DropOldest:
+		for {
+			select {
+			case s.ch <- e:
+				return
+			default:
+			}
+
+			select {
+			case <-s.ch:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+	}
+
+	select {
+	case s.ch <- e:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// watchHub dispatches the events of a map to its subscriptions. It is
+// notified while the map is locked, so that events keep the order of writes.
+type watchHub struct {
+	mu   sync.Mutex
+	subs []*Subscription
+	n    atomic.Int32
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{}
+}
+
+func (h *watchHub) watch(matcher Matcher, opts []WatchOption) *Subscription {
+	s := &Subscription{
+		matcher: matcher,
+		buffer:  DefaultWatchBuffer,
+		done:    make(chan struct{}),
+		hub:     h,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.buffer < 0 {
+		s.buffer = 0
+	}
+	if s.buffer == 0 && s.policy == DropOldest {
+		s.policy = DropNewest
+	}
+	s.ch = make(chan Event, s.buffer)
+	s.C = s.ch
+
+	h.mu.Lock()
+	h.subs = append(h.subs, s)
+	h.n.Add(1)
+	h.mu.Unlock()
+	return s
+}
+
+func (h *watchHub) remove(s *Subscription) {
+	h.mu.Lock()
+	for i, sub := range h.subs {
+		if sub == s {
+			h.subs = append(h.subs[:i], h.subs[i+1:]...)
+			h.n.Add(-1)
+			break
+		}
+	}
+	h.mu.Unlock()
+}
+
+// changed notifies a write of "v" on the key "k", "existed" says if the
+// key had the value "old".
+func (h *watchHub) changed(k string, old any, existed bool, v any) {
+	if existed {
+		h.notify(Event{Type: EventSet, Key: k, Old: old, New: v})
+	} else {
+		h.notify(Event{Type: EventAdd, Key: k, New: v})
+	}
+}
+
+func (h *watchHub) deleted(k string, old any) {
+	h.notify(Event{Type: EventDelete, Key: k, Old: old})
+}
+
+func (h *watchHub) notify(e Event) {
+	if h.n.Load() == 0 {
+		return
+	}
+
+	v := e.New
+	if e.Type == EventDelete {
+		v = e.Old
+	}
+
+	h.mu.Lock()
+	for _, s := range h.subs {
+		if s.match(e.Key, v) {
+			s.send(e)
+		}
+	}
+	h.mu.Unlock()
+}
+
+// reset notifies every subscription that the entries "old" were removed.
+func (h *watchHub) reset(old Map) {
+	if h.n.Load() == 0 {
+		return
+	}
+
+	h.mu.Lock()
+	for _, s := range h.subs {
+		removed := old.FindAll(s.match)
+		s.send(Event{Type: EventReset, Old: removed})
+	}
+	h.mu.Unlock()
+}
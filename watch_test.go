@@ -0,0 +1,152 @@
+package types
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// drain returns the buffered events of the subscription.
+func drain(s *Subscription) (out []Event) {
+	for {
+		select {
+		case e := <-s.C:
+			out = append(out, e)
+		default:
+			return
+		}
+	}
+}
+
+func TestSyncMap_Watch(t *testing.T) {
+	for name, m := range map[string]TSafeMap{
+		"SyncMap":        SyncMap(),
+		"SyncMapSharded": SyncMapSharded(4),
+		"SyncCache":      SyncCache(WithCleanupInterval(0)),
+	} {
+		all := m.Watch(nil)
+		db := m.Watch(KeyPrefix("db."))
+
+		m.Set("db.host", "a")
+		m.Set("db.host", "b")
+		m.Add("db.host", "c")
+		m.Add("name", "app")
+		m.Update("db.port", func(any, bool) any { return 5432 })
+		m.CompareAndSwap("db.port", 5432, 5433)
+		m.GetOrSet("db.user", "root")
+		m.Delete("db.host")
+		m.LoadAndDelete("name")
+		m.Reset()
+
+		assert.Equal(t, []Event{
+			{Type: EventAdd, Key: "db.host", New: "a"},
+			{Type: EventSet, Key: "db.host", Old: "a", New: "b"},
+			{Type: EventAdd, Key: "db.port", New: 5432},
+			{Type: EventSet, Key: "db.port", Old: 5432, New: 5433},
+			{Type: EventAdd, Key: "db.user", New: "root"},
+			{Type: EventDelete, Key: "db.host", Old: "b"},
+			{Type: EventReset, Old: Map{"db.port": 5433, "db.user": "root"}},
+		}, drain(db), name)
+		assert.Len(t, drain(all), 9, name)
+
+		all.Unsubscribe()
+		all.Unsubscribe()
+		_, ok := <-all.C
+		assert.False(t, ok, name)
+
+		m.Set("db.host", "d")
+		assert.Len(t, drain(db), 1, name)
+		db.Unsubscribe()
+	}
+}
+
+func TestSyncMap_WatchDropPolicy(t *testing.T) {
+	m := SyncMap()
+	newest := m.Watch(nil, WithBuffer(2))
+	oldest := m.Watch(nil, WithBuffer(2), WithDropPolicy(DropOldest))
+
+	for i := 0; i < 5; i++ {
+		m.Set("k", i)
+	}
+
+	assert.Equal(t, uint64(3), newest.Dropped())
+	events := drain(newest)
+	assert.Equal(t, []any{0, 1}, []any{events[0].New, events[1].New})
+
+	assert.Equal(t, uint64(3), oldest.Dropped())
+	events = drain(oldest)
+	assert.Equal(t, []any{3, 4}, []any{events[0].New, events[1].New})
+}
+
+func TestSyncMap_WatchNoBuffer(t *testing.T) {
+	m := SyncMap()
+	oldest := m.Watch(nil, WithBuffer(0), WithDropPolicy(DropOldest))
+	negative := m.Watch(nil, WithBuffer(-1))
+
+	// Nobody waits on C, the events are dropped rather than blocking.
+	m.Set("k", 1)
+	m.Set("k", 2)
+	assert.Equal(t, uint64(2), oldest.Dropped())
+	assert.Equal(t, uint64(2), negative.Dropped())
+	assert.Empty(t, drain(oldest))
+
+	oldest.Unsubscribe()
+	negative.Unsubscribe()
+}
+
+func TestSyncMap_WatchBlock(t *testing.T) {
+	m := SyncMap()
+	s := m.Watch(nil, WithBuffer(0), WithDropPolicy(Block))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			m.Set("k", i)
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		e := <-s.C
+		assert.Equal(t, i, e.New)
+	}
+	<-done
+	assert.Equal(t, uint64(0), s.Dropped())
+
+	// Unsubscribe releases a writer blocked on the subscription.
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m.Set("k", -1)
+	}()
+	s.Unsubscribe()
+	wg.Wait()
+}
+
+func TestSyncMap_WatchOrder(t *testing.T) {
+	m := SyncMap()
+	s := m.Watch(KeyPrefix("counter"), WithBuffer(1000))
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				m.Update("counter", func(old any, ok bool) any {
+					if !ok {
+						return 1
+					}
+					return old.(int) + 1
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, e := range drain(s) {
+		assert.Equal(t, i+1, e.New)
+	}
+}